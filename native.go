@@ -5,6 +5,7 @@ import (
 
 	"github.com/mxmCherry/openrtb/native"
 	"github.com/mxmCherry/openrtb/native/request"
+	"github.com/mxmCherry/openrtb/native/request/marshal"
 )
 
 // 3.2.9 Object: Native
@@ -120,8 +121,25 @@ type Native struct {
 	// Description:
 	//   The design/format/layout of the ad unit being offered.
 	//   See Table of Placement Type IDs below for a list of supported placement types.
+	//
+	// Deprecated: as of OpenRTB 2.6, superseded by Plcmt, which uses the same
+	// value list under a name that doesn't collide with the unrelated,
+	// also-deprecated Layout/AdUnit pair.
 	PlcmtType native.PlacementType `json:"plcmttype,omitempty"`
 
+	// Field:
+	//   plcmt
+	// Scope:
+	//   optional
+	// Type:
+	//   integer
+	// Description:
+	//   OpenRTB 2.6 clarification of PlcmtType: the design/format/layout of
+	//   the ad unit being offered. Only emitted when Ver indicates a spec
+	//   version new enough to understand it (unset Ver, or a known version
+	//   >= 1.2); a Ver that is not one of 1.0/1.1/1.2 counts as older.
+	Plcmt native.PlcmtType `json:"plcmt,omitempty"`
+
 	// Field:
 	//   plcmtcnt
 	// Scope:
@@ -209,3 +227,22 @@ type Native struct {
 	//   Set to 0 (or field absent) when the native ad doesn’t support custom privacy links or if support is unknown.
 	Privacy int8 `json:"privacy,omitempty"`
 }
+
+// WithTypedRequest builds req into the Dynamic Native Ads API JSON string
+// and stores it in n.Request, so bidders don't have to roll their own
+// marshaling of the opaque Request payload.
+func (n *Native) WithTypedRequest(req *request.Request) error {
+	raw, err := marshal.Build(req)
+	if err != nil {
+		return err
+	}
+
+	n.Request = raw
+
+	return nil
+}
+
+// TypedRequest parses n.Request into the typed request.Request it encodes.
+func (n *Native) TypedRequest() (*request.Request, error) {
+	return marshal.Parse(n.Request)
+}
@@ -0,0 +1,26 @@
+package native
+
+// AdInsertion is the OpenRTB 2.6 server-side/client-side ad insertion type
+// for Imp.SSAI (external doc 3, section 3.2.4). The top-level Imp type is
+// out of scope for this module - it lives in the root openrtb2 package,
+// which this snapshot doesn't include - so AdInsertion is defined here
+// unattached; wire it up as Imp.SSAI AdInsertion alongside the rest of
+// Imp's fields when that type is in reach.
+type AdInsertion int64
+
+const (
+	// AdInsertionUnknown indicates SSAI status is unknown.
+	AdInsertionUnknown AdInsertion = 0
+
+	// AdInsertionClientSide indicates the creative is stitched into the
+	// content client-side.
+	AdInsertionClientSide AdInsertion = 1
+
+	// AdInsertionServerSide indicates the creative is stitched into the
+	// content server-side, with no tracking/URL modification.
+	AdInsertionServerSide AdInsertion = 2
+
+	// AdInsertionServerSideTrackingModified indicates server-side insertion
+	// with tracking URLs modified for the stitched stream.
+	AdInsertionServerSideTrackingModified AdInsertion = 3
+)
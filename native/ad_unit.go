@@ -0,0 +1,18 @@
+package native
+
+// AdUnit is the Ad unit ID of the native ad unit.
+//
+// Deprecated: AdUnit is part of the deprecated "unified" native ad unit
+// approach from the Native 1.0 spec; use ContextType, PlacementType, etc.
+// (Section 7.7) for 1.1+.
+type AdUnit int64
+
+const (
+	AdUnitPaidSearchUnit       AdUnit = 1
+	AdUnitRecommendationWidget AdUnit = 2
+	AdUnitPromotedListing      AdUnit = 3
+	AdUnitInAdNativeAdUnit     AdUnit = 4
+	AdUnitADUnit               AdUnit = 5
+	AdUnitContentStream        AdUnit = 6
+	// 500+ are exchange-specific; the definitions are not published.
+)
@@ -0,0 +1,37 @@
+package native
+
+// ContextSubType is a more detailed context in which the ad appears.
+//
+// Each of these have an implied parent ContextType.
+type ContextSubType int64
+
+const (
+	// General or mixed content.
+	ContextSubTypeGeneral ContextSubType = 10
+	// Primarily article content, which could include images, etc as part of the article.
+	ContextSubTypeArticle ContextSubType = 11
+	// Primarily video content.
+	ContextSubTypeVideo ContextSubType = 12
+	// Primarily audio content.
+	ContextSubTypeAudio ContextSubType = 13
+	// Primarily image content.
+	ContextSubTypeImage ContextSubType = 14
+	// User-generated content - forums, comments, etc.
+	ContextSubTypeUserGenerated ContextSubType = 15
+
+	// General social content such as a general social network.
+	ContextSubTypeSocial ContextSubType = 20
+	// Primarily email content.
+	ContextSubTypeEmail ContextSubType = 21
+	// Primarily chat/IM content.
+	ContextSubTypeChatIM ContextSubType = 22
+
+	// Content focused on selling products, whether digital or physical.
+	ContextSubTypeSellingProducts ContextSubType = 30
+	// Application store/marketplace.
+	ContextSubTypeAppStore ContextSubType = 31
+	// Product reviews site primarily, which may sell product secondarily.
+	ContextSubTypeProductReviews ContextSubType = 32
+
+	// 500+ are exchange-specific; the definitions are not published.
+)
@@ -0,0 +1,25 @@
+package native
+
+// ContextType is the context in which the ad appears - what type of content
+// is surrounding the ad unit on the page at a high level.
+//
+// This is important for the buy-side targeting and brand safety, for
+// instance, many brands will prefer only being on content type sites, not
+// social sites.
+type ContextType int64
+
+const (
+	// ContextTypeContent - Content-centric context such as newsfeed, article,
+	// image gallery, video gallery, or similar.
+	ContextTypeContent ContextType = 1
+
+	// ContextTypeSocial - Social-centric context such as social network feed,
+	// email, chat, or similar.
+	ContextTypeSocial ContextType = 2
+
+	// ContextTypeProduct - Product context such as product listings, details,
+	// recommendations, reviews, or similar.
+	ContextTypeProduct ContextType = 3
+
+	// 500+ are exchange-specific; the definitions are not published.
+)
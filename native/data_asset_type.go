@@ -0,0 +1,24 @@
+package native
+
+// DataAssetType is the type of data element being submitted for the Data Asset Object.
+//
+// Types 500+ hold custom values, defined by the exchange, for non-standard
+// asset types (e.g. ratings in different currencies or sentiment).
+type DataAssetType int64
+
+const (
+	DataAssetTypeSponsored  DataAssetType = 1
+	DataAssetTypeDesc       DataAssetType = 2
+	DataAssetTypeRating     DataAssetType = 3
+	DataAssetTypeLikes      DataAssetType = 4
+	DataAssetTypeDownloads  DataAssetType = 5
+	DataAssetTypePrice      DataAssetType = 6
+	DataAssetTypeSalePrice  DataAssetType = 7
+	DataAssetTypePhone      DataAssetType = 8
+	DataAssetTypeAddress    DataAssetType = 9
+	DataAssetTypeDesc2      DataAssetType = 10
+	DataAssetTypeDisplayURL DataAssetType = 11
+	DataAssetTypeCTAText    DataAssetType = 12
+
+	// 500+ are exchange-specific; the definitions are not published.
+)
@@ -0,0 +1,15 @@
+package native
+
+// EventTrackingMethod is the event tracking method requested/supported for an
+// Event Trackers Request Object.
+type EventTrackingMethod int64
+
+const (
+	// Image-pixel tracking - URL provided will be inserted as a 1x1 pixel at the time of the event.
+	EventTrackingMethodImage EventTrackingMethod = 1
+
+	// Javascript-based tracking - URL provided will be inserted as a js tag at the time of the event.
+	EventTrackingMethodJS EventTrackingMethod = 2
+
+	// 500+ are exchange-specific; the definitions are not published.
+)
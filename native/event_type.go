@@ -0,0 +1,20 @@
+package native
+
+// EventType is the type of event being tracked by an Event Trackers Request Object.
+type EventType int64
+
+const (
+	// Impression.
+	EventTypeImpression EventType = 1
+
+	// Visible impression using MRC definition at 50% in view for 1 second.
+	EventTypeViewableMRC50 EventType = 2
+
+	// 100% in view for 1 second (GroupM standard).
+	EventTypeViewableMRC100 EventType = 3
+
+	// Visible impression for video using MRC definition at 50% in view for 2 seconds.
+	EventTypeViewableVideo50 EventType = 4
+
+	// 500+ are exchange-specific; the definitions are not published.
+)
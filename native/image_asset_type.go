@@ -0,0 +1,30 @@
+package native
+
+// ImageAssetType is the type of image element being submitted for the Image Asset Object.
+type ImageAssetType int64
+
+const (
+	// Icon image.
+	//
+	// Max height: at least 50
+	// Aspect ratio: 1:1
+	ImageAssetTypeIcon ImageAssetType = 1
+
+	// Logo image for the brand/app.
+	ImageAssetTypeLogo ImageAssetType = 2
+
+	// Large image preview for the ad.
+	//
+	// At least one of 2 size variants required:
+	// Small Variant:
+	//   Max height: at least 200
+	//   Max width: at least 200, 267, or 382
+	//   Aspect ratio: 1:1, 4:3, or 1.91:1
+	// Large Variant:
+	//   Max height: at least 627
+	//   Max width: at least 627, 836, or 1198
+	//   Aspect ratio: 1:1, 4:3, or 1.91:1
+	ImageAssetTypeMain ImageAssetType = 3
+
+	// 500+ are exchange-specific; the definitions are not published.
+)
@@ -0,0 +1,19 @@
+package native
+
+// Layout is the Layout ID of the native ad unit.
+//
+// Deprecated: Layout is part of the deprecated "unified" native ad unit
+// approach from the Native 1.0 spec; use ContextType, PlacementType, etc.
+// (Section 7.7) for 1.1+.
+type Layout int64
+
+const (
+	LayoutContentWall   Layout = 1
+	LayoutAppWall       Layout = 2
+	LayoutNewsFeed      Layout = 3
+	LayoutChatList      Layout = 4
+	LayoutCarousel      Layout = 5
+	LayoutContentStream Layout = 6
+	LayoutGrid          Layout = 7
+	// 500+ are exchange-specific; the definitions are not published.
+)
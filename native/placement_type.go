@@ -0,0 +1,21 @@
+package native
+
+// PlacementType is the design/format/layout of the ad unit being offered.
+type PlacementType int64
+
+const (
+	// In the feed of content - for example as an item inside the organic feed/grid/listing/carousel.
+	PlacementTypeInFeed PlacementType = 1
+
+	// In the atomic unit of the content - i.e., in the article page or single image page.
+	PlacementTypeAtomicUnit PlacementType = 2
+
+	// Outside the core content - for example in the ads section on the side of a page, in a
+	// dedicated ad slot somewhere on the page, any interstitial etc.
+	PlacementTypeOutside PlacementType = 3
+
+	// Recommendation widget, most commonly presented below the article content.
+	PlacementTypeRecommendationWidget PlacementType = 4
+
+	// 500+ are exchange-specific; the definitions are not published.
+)
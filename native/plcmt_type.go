@@ -0,0 +1,31 @@
+package native
+
+// PlcmtType is the OpenRTB 2.6 clarification of the native ad unit's
+// design/format/layout, carried in the new Native.Plcmt field.
+//
+// It supersedes the overloaded PlacementType/Native.PlcmtType pairing from
+// the 1.2-era Dynamic Native Ads API, which conflated "placement type" (this
+// list) with the deprecated Layout/AdUnit pair; the values themselves are
+// unchanged.
+type PlcmtType int64
+
+const (
+	// PlcmtTypeContentFeed is in the feed of content - for example as an
+	// item inside the organic feed/grid/listing/carousel.
+	PlcmtTypeContentFeed PlcmtType = 1
+
+	// PlcmtTypeContentAtomicUnit is in the atomic unit of the content - i.e.,
+	// in the article page or single image page.
+	PlcmtTypeContentAtomicUnit PlcmtType = 2
+
+	// PlcmtTypeOutsideContent is outside the core content - for example in
+	// the ads section on the side of a page, in a dedicated ad slot
+	// somewhere on the page, any interstitial, etc.
+	PlcmtTypeOutsideContent PlcmtType = 3
+
+	// PlcmtTypeRecommendationWidget is a recommendation widget, most
+	// commonly presented below the article content.
+	PlcmtTypeRecommendationWidget PlcmtType = 4
+
+	// 500+ are exchange-specific; the definitions are not published.
+)
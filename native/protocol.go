@@ -0,0 +1,19 @@
+package native
+
+// Protocol is the supported video bid response protocol, mirroring OpenRTB's
+// List 5.8, duplicated here so the native subpackage has no dependency on the
+// top-level openrtb package.
+type Protocol int64
+
+const (
+	ProtocolVAST1         Protocol = 1
+	ProtocolVAST2         Protocol = 2
+	ProtocolVAST3         Protocol = 3
+	ProtocolVAST1Wrapper  Protocol = 4
+	ProtocolVAST2Wrapper  Protocol = 5
+	ProtocolVAST3Wrapper  Protocol = 6
+	ProtocolVAST4         Protocol = 7
+	ProtocolVAST4Wrapper  Protocol = 8
+	ProtocolDAAST1        Protocol = 9
+	ProtocolDAAST1Wrapper Protocol = 10
+)
@@ -0,0 +1,68 @@
+package request
+
+import "encoding/json"
+
+// Asset object represents the Asset Object (Request) of the Dynamic Native
+// Ads API.
+//
+// Only one of the Title, Img, Data, or Video objects should be present in
+// each Asset Object.
+type Asset struct {
+	// Attribute:
+	//   id
+	// Type:
+	//   integer; required
+	// Description:
+	//   Unique asset ID, assigned by exchange. Typically a counter for the
+	//   array.
+	ID int64 `json:"id"`
+
+	// Attribute:
+	//   required
+	// Type:
+	//   integer; default 0
+	// Description:
+	//   Set to 1 if asset is required (exchange will not accept a bid
+	//   without it).
+	Required int8 `json:"required,omitempty"`
+
+	// Attribute:
+	//   title
+	// Type:
+	//   object; recommended
+	// Description:
+	//   The Title Object.
+	Title *Title `json:"title,omitempty"`
+
+	// Attribute:
+	//   img
+	// Type:
+	//   object; recommended
+	// Description:
+	//   The Image Object.
+	Img *Image `json:"img,omitempty"`
+
+	// Attribute:
+	//   video
+	// Type:
+	//   object; recommended
+	// Description:
+	//   The Video Object.
+	Video *Video `json:"video,omitempty"`
+
+	// Attribute:
+	//   data
+	// Type:
+	//   object; recommended
+	// Description:
+	//   The Data Object.
+	Data *Data `json:"data,omitempty"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
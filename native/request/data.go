@@ -0,0 +1,39 @@
+package request
+
+import (
+	"encoding/json"
+
+	"github.com/mxmCherry/openrtb/native"
+)
+
+// Data object represents the Data Object (Request) of the Dynamic Native
+// Ads API.
+//
+// The Data Object is to be used for all non-core elements of the native
+// unit such as Ratings, Review Count, Stars, Download count, descriptions
+// etc.
+type Data struct {
+	// Attribute:
+	//   type
+	// Type:
+	//   integer; required
+	// Description:
+	//   Type ID of the element supported by the publisher.
+	Type native.DataAssetType `json:"type"`
+
+	// Attribute:
+	//   len
+	// Type:
+	//   integer; optional
+	// Description:
+	//   Maximum length of the text in the element's response.
+	Len int64 `json:"len,omitempty"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
@@ -0,0 +1,40 @@
+package request
+
+import (
+	"encoding/json"
+
+	"github.com/mxmCherry/openrtb/native"
+)
+
+// EventTracker object represents the Event Trackers Request Object of the
+// Dynamic Native Ads API.
+//
+// The event trackers Object specifies the types of events the bidder can
+// request to be tracked in the bid response, and which types of tracking
+// are available for each event type, and is included as an array in the
+// request.
+type EventTracker struct {
+	// Attribute:
+	//   event
+	// Type:
+	//   integer; required
+	// Description:
+	//   Type of event available for tracking.
+	Event native.EventType `json:"event"`
+
+	// Attribute:
+	//   methods
+	// Type:
+	//   array of integers; required
+	// Description:
+	//   Array of the types of tracking available for the given event.
+	Methods []native.EventTrackingMethod `json:"methods"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
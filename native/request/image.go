@@ -0,0 +1,70 @@
+package request
+
+import (
+	"encoding/json"
+
+	"github.com/mxmCherry/openrtb/native"
+)
+
+// Image object represents the Image Object (Request) of the Dynamic Native
+// Ads API.
+//
+// The Image object to be used for all image elements of the Native ad such
+// as Icon and Image.
+type Image struct {
+	// Attribute:
+	//   type
+	// Type:
+	//   integer; recommended
+	// Description:
+	//   Type ID of the image element supported by the publisher.
+	Type native.ImageAssetType `json:"type,omitempty"`
+
+	// Attribute:
+	//   w
+	// Type:
+	//   integer; optional
+	// Description:
+	//   Width of the image in pixels.
+	W int64 `json:"w,omitempty"`
+
+	// Attribute:
+	//   wmin
+	// Type:
+	//   integer; optional
+	// Description:
+	//   The minimum requested width of the image in pixels.
+	WMin int64 `json:"wmin,omitempty"`
+
+	// Attribute:
+	//   h
+	// Type:
+	//   integer; optional
+	// Description:
+	//   Height of the image in pixels.
+	H int64 `json:"h,omitempty"`
+
+	// Attribute:
+	//   hmin
+	// Type:
+	//   integer; optional
+	// Description:
+	//   The minimum requested height of the image in pixels.
+	HMin int64 `json:"hmin,omitempty"`
+
+	// Attribute:
+	//   mimes
+	// Type:
+	//   string array; optional
+	// Description:
+	//   Whitelist of content MIME types supported.
+	Mimes []string `json:"mimes,omitempty"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
@@ -0,0 +1,55 @@
+// Package marshal builds and parses the opaque, doubly-JSON-encoded string
+// carried in openrtb.Native.Request, so that bidders can work with the
+// typed request.Request/request.Asset/request.EventTracker structs instead
+// of hand-assembling JSON.
+package marshal
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mxmCherry/openrtb/native/request"
+)
+
+// Build validates req and serializes it to the JSON string expected in
+// openrtb.Native.Request. The serialized payload always carries an explicit
+// plcmtcnt (defaulting to 1 per spec) rather than relying on the reader to
+// apply that default itself.
+//
+// Exchange-specific extensions carried in req.Ext (and nested Asset.Ext,
+// etc.) are passed through as-is via json.RawMessage, so they round-trip
+// unchanged.
+func Build(req *request.Request) (string, error) {
+	if req == nil {
+		return "", fmt.Errorf("marshal: nil request")
+	}
+
+	if err := req.Validate(); err != nil {
+		return "", fmt.Errorf("marshal: %w", err)
+	}
+
+	out := *req
+	out.PlcmtCnt = req.NormalizedPlcmtCnt()
+
+	b, err := json.Marshal(&out)
+	if err != nil {
+		return "", fmt.Errorf("marshal: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// Parse decodes a raw openrtb.Native.Request string into a typed
+// request.Request and validates it.
+func Parse(raw string) (*request.Request, error) {
+	var req request.Request
+	if err := json.Unmarshal([]byte(raw), &req); err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+
+	return &req, nil
+}
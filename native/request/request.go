@@ -0,0 +1,123 @@
+package request
+
+import (
+	"encoding/json"
+
+	"github.com/mxmCherry/openrtb/native"
+)
+
+// Request object represents the Native Object (Request) of the Dynamic
+// Native Ads API.
+//
+// This is the top-level object for the native ad request; it is carried as
+// an opaque, serialized string in openrtb.Native.Request.
+type Request struct {
+	// Attribute:
+	//   ver
+	// Type:
+	//   string; recommended
+	// Description:
+	//   Version of the Native Markup version in use.
+	Ver string `json:"ver,omitempty"`
+
+	// Attribute:
+	//   context
+	// Type:
+	//   integer; recommended
+	// Description:
+	//   The context in which the ad appears.
+	Context native.ContextType `json:"context,omitempty"`
+
+	// Attribute:
+	//   contextsubtype
+	// Type:
+	//   integer; optional
+	// Description:
+	//   A more detailed context in which the ad appears.
+	ContextSubType native.ContextSubType `json:"contextsubtype,omitempty"`
+
+	// Attribute:
+	//   plcmttype
+	// Type:
+	//   integer; recommended
+	// Description:
+	//   The design/format/layout of the ad unit being offered.
+	PlcmtType native.PlacementType `json:"plcmttype,omitempty"`
+
+	// Attribute:
+	//   plcmtcnt
+	// Type:
+	//   integer; optional
+	// Default:
+	//   1
+	// Description:
+	//   The number of identical placements in this Layout.
+	PlcmtCnt int64 `json:"plcmtcnt,omitempty"`
+
+	// Attribute:
+	//   seq
+	// Type:
+	//   integer; optional
+	// Default:
+	//   0
+	// Description:
+	//   0 for the first ad, 1 for the second ad, and so on.
+	Seq int64 `json:"seq,omitempty"`
+
+	// Attribute:
+	//   assets
+	// Type:
+	//   array of objects; required
+	// Description:
+	//   An array of Asset Objects. Any bid must comply with the array of
+	//   elements expressed by the exchange.
+	Assets []Asset `json:"assets"`
+
+	// Attribute:
+	//   aurlsupport
+	// Type:
+	//   integer; optional
+	// Default:
+	//   0
+	// Description:
+	//   Whether the supply source / impression supports returning an
+	//   assetsurl instead of an asset object.
+	AURLSupport int8 `json:"aurlsupport,omitempty"`
+
+	// Attribute:
+	//   durlsupport
+	// Type:
+	//   integer; optional
+	// Default:
+	//   0
+	// Description:
+	//   Whether the supply source / impression supports returning a dco url
+	//   instead of an asset object. Beta feature.
+	DURLSupport int8 `json:"durlsupport,omitempty"`
+
+	// Attribute:
+	//   eventtrackers
+	// Type:
+	//   array of objects; optional
+	// Description:
+	//   Specifies what type of event tracking is supported.
+	EventTrackers []EventTracker `json:"eventtrackers,omitempty"`
+
+	// Attribute:
+	//   privacy
+	// Type:
+	//   integer; recommended
+	// Default:
+	//   0
+	// Description:
+	//   Set to 1 when the native ad supports buyer-specific privacy notice.
+	Privacy int8 `json:"privacy,omitempty"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
@@ -0,0 +1,26 @@
+package request
+
+import "encoding/json"
+
+// Title object represents the Title Object (Request) of the Dynamic Native
+// Ads API.
+//
+// The Title object is to be used for title element of the Native ad.
+type Title struct {
+	// Attribute:
+	//   len
+	// Type:
+	//   integer; required
+	// Description:
+	//   Maximum length of the text in the title element.
+	//   Recommended to be 25, 90, or 140.
+	Len int64 `json:"len"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
@@ -0,0 +1,85 @@
+package request
+
+import "errors"
+
+// specVersions lists the Dynamic Native Ads API versions this package
+// understands. Ver is recommended but, per spec, may be absent.
+var specVersions = map[string]bool{
+	"1.0": true,
+	"1.1": true,
+	"1.2": true,
+}
+
+// Validate checks this Request against the rules of the Dynamic Native Ads
+// API spec that aren't already enforced by the Go type system: that Assets
+// is non-empty, that each Asset carries at most one of Title/Img/Data/Video,
+// that PlcmtCnt defaults sensibly, and that Ver (when set) names a known
+// spec version.
+//
+// It does not attempt to validate nested Asset fields (e.g. Title.Len) -
+// those are the bidder's responsibility to size according to the spec.
+func (r *Request) Validate() error {
+	if r.Ver != "" && !specVersions[r.Ver] {
+		return errors.New("request: unsupported ver " + r.Ver)
+	}
+
+	if len(r.Assets) == 0 {
+		return errors.New("request: assets must not be empty")
+	}
+
+	for _, asset := range r.Assets {
+		if err := asset.validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, tracker := range r.EventTrackers {
+		if err := tracker.validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.PlcmtCnt < 0 {
+		return errors.New("request: plcmtcnt must not be negative")
+	}
+
+	return nil
+}
+
+// NormalizedPlcmtCnt returns PlcmtCnt, defaulting the spec's implicit
+// default of 1 when the field was left unset. marshal.Build calls this so
+// the serialized payload always carries an explicit plcmtcnt rather than
+// relying on every reader to apply the same default.
+func (r *Request) NormalizedPlcmtCnt() int64 {
+	if r.PlcmtCnt == 0 {
+		return 1
+	}
+	return r.PlcmtCnt
+}
+
+func (a *Asset) validate() error {
+	present := 0
+	if a.Title != nil {
+		present++
+	}
+	if a.Img != nil {
+		present++
+	}
+	if a.Data != nil {
+		present++
+	}
+	if a.Video != nil {
+		present++
+	}
+	if present > 1 {
+		return errors.New("request: asset must carry at most one of title/img/data/video")
+	}
+	return nil
+}
+
+func (t *EventTracker) validate() error {
+	if len(t.Methods) == 0 {
+		return errors.New("request: eventtracker must list at least one method")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package request
+
+import (
+	"encoding/json"
+
+	"github.com/mxmCherry/openrtb/native"
+)
+
+// Video object represents the Video Object (Request) of the Dynamic Native
+// Ads API.
+//
+// The video object to be used for all video elements supported in the
+// Native Ad. This corresponds to the VAST object in the openrtb.Video
+// object; however, the VAST line items need to be XML, hence a set of
+// attributes for the native context are defined here.
+type Video struct {
+	// Attribute:
+	//   mimes
+	// Type:
+	//   string array; required
+	// Description:
+	//   Content MIME types supported.
+	Mimes []string `json:"mimes"`
+
+	// Attribute:
+	//   minduration
+	// Type:
+	//   integer; required
+	// Description:
+	//   Minimum video ad duration in seconds.
+	MinDuration int64 `json:"minduration"`
+
+	// Attribute:
+	//   maxduration
+	// Type:
+	//   integer; required
+	// Description:
+	//   Maximum video ad duration in seconds.
+	MaxDuration int64 `json:"maxduration"`
+
+	// Attribute:
+	//   protocols
+	// Type:
+	//   integer array; required
+	// Description:
+	//   Array of supported video protocols.
+	Protocols []native.Protocol `json:"protocols"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
@@ -0,0 +1,77 @@
+package response
+
+import "encoding/json"
+
+// Asset object represents the Asset Object (Response) of the Dynamic Native
+// Ads API.
+//
+// Only one of the Title, Img, Data, or Video objects should be present in
+// each Asset Object. The ID must map to an Asset Object in the corresponding
+// bid request.
+type Asset struct {
+	// Attribute:
+	//   id
+	// Type:
+	//   integer; required
+	// Description:
+	//   Unique asset ID, must match one of the asset IDs in request.
+	ID int64 `json:"id"`
+
+	// Attribute:
+	//   required
+	// Type:
+	//   integer; default 0
+	// Description:
+	//   Set to 1 if asset is required. (bidders should not need to set
+	//   this in the response, it is carried over for convenience only).
+	Required int8 `json:"required,omitempty"`
+
+	// Attribute:
+	//   title
+	// Type:
+	//   object; recommended
+	// Description:
+	//   The Title object.
+	Title *Title `json:"title,omitempty"`
+
+	// Attribute:
+	//   img
+	// Type:
+	//   object; recommended
+	// Description:
+	//   The Image object.
+	Img *Image `json:"img,omitempty"`
+
+	// Attribute:
+	//   video
+	// Type:
+	//   object; recommended
+	// Description:
+	//   The Video object.
+	Video *Video `json:"video,omitempty"`
+
+	// Attribute:
+	//   data
+	// Type:
+	//   object; recommended
+	// Description:
+	//   The Data object.
+	Data *Data `json:"data,omitempty"`
+
+	// Attribute:
+	//   link
+	// Type:
+	//   object; optional
+	// Description:
+	//   Asset-level link that overrides the default destination link of
+	//   the ad.
+	Link *Link `json:"link,omitempty"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
@@ -0,0 +1,33 @@
+package response
+
+import "encoding/json"
+
+// Data object represents the Data Object (Response) of the Dynamic Native
+// Ads API.
+type Data struct {
+	// Attribute:
+	//   label
+	// Type:
+	//   string; optional
+	// Description:
+	//   The optional formatted string name of the data type to be
+	//   displayed.
+	Label string `json:"label,omitempty"`
+
+	// Attribute:
+	//   value
+	// Type:
+	//   string; required
+	// Description:
+	//   The formatted string of data to be displayed. Can contain a
+	//   formatted value such as "5 stars" or "3.4 stars" out of 5.
+	Value string `json:"value"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
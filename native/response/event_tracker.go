@@ -0,0 +1,57 @@
+package response
+
+import (
+	"encoding/json"
+
+	"github.com/mxmCherry/openrtb/native"
+)
+
+// EventTracker object represents the Event Trackers Response Object of the
+// Dynamic Native Ads API.
+//
+// The event trackers response is an array of objects that specify simple
+// ways to track events by URL and methods it supports.
+type EventTracker struct {
+	// Attribute:
+	//   event
+	// Type:
+	//   integer; required
+	// Description:
+	//   Type of event being tracked.
+	Event native.EventType `json:"event"`
+
+	// Attribute:
+	//   method
+	// Type:
+	//   integer; required
+	// Description:
+	//   Type of tracking requested.
+	Method native.EventTrackingMethod `json:"method"`
+
+	// Attribute:
+	//   url
+	// Type:
+	//   string; optional
+	// Description:
+	//   The URL of the image or js. Required for image or js, optional for
+	//   custom.
+	URL string `json:"url,omitempty"`
+
+	// Attribute:
+	//   customdata
+	// Type:
+	//   object; optional
+	// Description:
+	//   To be agreed individually with the exchange, an array of key:value
+	//   objects for custom tracking, for example the account number of the
+	//   DSP with a tracking server.
+	CustomData json.RawMessage `json:"customdata,omitempty"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
@@ -0,0 +1,39 @@
+package response
+
+import "encoding/json"
+
+// Image object represents the Image Object (Response) of the Dynamic Native
+// Ads API.
+type Image struct {
+	// Attribute:
+	//   url
+	// Type:
+	//   string; required
+	// Description:
+	//   URL of the image asset.
+	URL string `json:"url"`
+
+	// Attribute:
+	//   w
+	// Type:
+	//   int; recommended
+	// Description:
+	//   Width of the image in pixels.
+	W int64 `json:"w,omitempty"`
+
+	// Attribute:
+	//   h
+	// Type:
+	//   int; recommended
+	// Description:
+	//   Height of the image in pixels.
+	H int64 `json:"h,omitempty"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
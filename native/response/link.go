@@ -0,0 +1,42 @@
+package response
+
+import "encoding/json"
+
+// Link object represents the Link Object (Response) of the Dynamic Native
+// Ads API.
+//
+// Used for ‘call to action’ assets, or other links from the Native Ad.
+type Link struct {
+	// Attribute:
+	//   url
+	// Type:
+	//   string; required
+	// Description:
+	//   Landing URL of the clickable link.
+	URL string `json:"url"`
+
+	// Attribute:
+	//   clicktrackers
+	// Type:
+	//   array of strings; optional
+	// Description:
+	//   List of third-party tracker URLs to be fired on click of the URL.
+	ClickTrackers []string `json:"clicktrackers,omitempty"`
+
+	// Attribute:
+	//   fallback
+	// Type:
+	//   string; optional
+	// Description:
+	//   Fallback URL for deeplink. To be used if the URL given in url is
+	//   not supported by the device.
+	Fallback string `json:"fallback,omitempty"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
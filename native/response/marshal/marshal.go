@@ -0,0 +1,110 @@
+// Package marshal builds, validates and parses the Native Object (Response)
+// markup that bidders return inside openrtb.Bid.AdM, cross-checking it
+// against the request.Request that was advertised for the impression.
+package marshal
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mxmCherry/openrtb/native/request"
+	"github.com/mxmCherry/openrtb/native/response"
+)
+
+// wrappedVers lists the Dynamic Native Ads API versions whose markup is
+// expected wrapped in {"native": {...}}, for compatibility with renderers
+// built against the 1.0 spec.
+var wrappedVers = map[string]bool{
+	"1.0": true,
+}
+
+// envelope is the {"native": {...}} wrapper used by pre-1.1 renderers.
+type envelope struct {
+	Response response.Response `json:"native"`
+}
+
+// Build validates resp against nativeReq and serializes it to the markup
+// string expected in openrtb.Bid.AdM, wrapping it in {"native": {...}} when
+// resp.Ver calls for it.
+//
+// nativeReq may be nil to skip the asset-level cross-check (e.g. when the
+// caller has already validated elsewhere), but resp.AssetsURL/DCOURL still
+// require nativeReq: the request's AURLSupport/DURLSupport flags are the
+// only way to know the exchange actually advertised support for them, so a
+// nil nativeReq with either URL set is rejected rather than silently
+// allowed.
+func Build(nativeReq *request.Request, resp *response.Response) (string, error) {
+	if resp == nil {
+		return "", fmt.Errorf("marshal: nil response")
+	}
+
+	if nativeReq != nil {
+		if errs := Validate(nativeReq, resp); len(errs) > 0 {
+			return "", fmt.Errorf("marshal: %w", errs[0])
+		}
+	}
+
+	out := resp
+	if resp.AssetsURL != "" || resp.DCOURL != "" {
+		alt, err := alternate(nativeReq, resp)
+		if err != nil {
+			return "", err
+		}
+		out = alt
+	}
+
+	return wrap(out)
+}
+
+// alternate builds the AssetsURL/DCOURL alternative encoding: the same
+// Response object, but with Assets omitted in favor of the URL field,
+// gated on the request having advertised support for it via AURLSupport/
+// DURLSupport.
+func alternate(nativeReq *request.Request, resp *response.Response) (*response.Response, error) {
+	if resp.AssetsURL != "" && (nativeReq == nil || nativeReq.AURLSupport == 0) {
+		return nil, fmt.Errorf("marshal: assetsurl is set but the request did not advertise aurlsupport")
+	}
+	if resp.DCOURL != "" && (nativeReq == nil || nativeReq.DURLSupport == 0) {
+		return nil, fmt.Errorf("marshal: dcourl is set but the request did not advertise durlsupport")
+	}
+
+	alt := *resp
+	alt.Assets = nil
+
+	return &alt, nil
+}
+
+// wrap serializes resp, applying the {"native": {...}} envelope for
+// versions that call for it.
+func wrap(resp *response.Response) (string, error) {
+	var (
+		b   []byte
+		err error
+	)
+	if wrappedVers[resp.Ver] {
+		b, err = json.Marshal(envelope{Response: *resp})
+	} else {
+		b, err = json.Marshal(resp)
+	}
+	if err != nil {
+		return "", fmt.Errorf("marshal: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// Parse decodes Native Object (Response) markup, accepting both the bare
+// form and the legacy {"native": {...}} envelope.
+func Parse(raw string) (*response.Response, error) {
+	var env envelope
+	if err := json.Unmarshal([]byte(raw), &env); err == nil && env.Response.Link.URL != "" {
+		return &env.Response, nil
+	}
+
+	var resp response.Response
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,115 @@
+package marshal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mxmCherry/openrtb/native/request"
+	"github.com/mxmCherry/openrtb/native/response"
+)
+
+func titleReq() *request.Request {
+	return &request.Request{
+		Ver: "1.2",
+		Assets: []request.Asset{
+			{ID: 1, Required: 1, Title: &request.Title{Len: 25}},
+		},
+	}
+}
+
+func TestBuild_BareAndWrapped(t *testing.T) {
+	resp := &response.Response{
+		Ver:    "1.2",
+		Link:   response.Link{URL: "https://example.com"},
+		Assets: []response.Asset{{ID: 1, Title: &response.Title{Text: "hello"}}},
+	}
+
+	out, err := Build(titleReq(), resp)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if strings.Contains(out, `"native"`) {
+		t.Fatalf("expected bare markup for ver 1.2, got %s", out)
+	}
+
+	resp.Ver = "1.0"
+	out, err = Build(titleReq(), resp)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if !strings.HasPrefix(out, `{"native":`) {
+		t.Fatalf("expected {\"native\":...} envelope for ver 1.0, got %s", out)
+	}
+}
+
+func TestBuild_RejectsAssetMismatch(t *testing.T) {
+	resp := &response.Response{
+		Link:   response.Link{URL: "https://example.com"},
+		Assets: []response.Asset{{ID: 1, Img: &response.Image{URL: "https://example.com/i.png"}}},
+	}
+
+	if _, err := Build(titleReq(), resp); err == nil {
+		t.Fatal("expected error for asset kind mismatch (img where title was requested)")
+	}
+}
+
+func TestBuild_AssetsURLRequiresSupport(t *testing.T) {
+	resp := &response.Response{
+		Link:      response.Link{URL: "https://example.com"},
+		AssetsURL: "https://cdn.example.com/assets.json",
+	}
+
+	req := titleReq()
+	req.Assets[0].Required = 0
+	req.AURLSupport = 0
+	if _, err := Build(req, resp); err == nil {
+		t.Fatal("expected error: assetsurl set without aurlsupport")
+	}
+
+	req.AURLSupport = 1
+	out, err := Build(req, resp)
+	if err != nil {
+		t.Fatalf("Build with aurlsupport: %v", err)
+	}
+	if !strings.Contains(out, "assetsurl") {
+		t.Fatalf("expected assetsurl in output, got %s", out)
+	}
+	if strings.Contains(out, `"assets"`) {
+		t.Fatalf("expected assets to be omitted alongside assetsurl, got %s", out)
+	}
+}
+
+func TestBuild_AssetsURLWithoutRequestIsRejected(t *testing.T) {
+	resp := &response.Response{
+		Link:      response.Link{URL: "https://example.com"},
+		AssetsURL: "https://cdn.example.com/assets.json",
+	}
+
+	if _, err := Build(nil, resp); err == nil {
+		t.Fatal("expected error: cannot verify aurlsupport without nativeReq")
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	resp := &response.Response{
+		Ver:    "1.0",
+		Link:   response.Link{URL: "https://example.com"},
+		Assets: []response.Asset{{ID: 1, Title: &response.Title{Text: "hello"}}},
+	}
+
+	out, err := Build(titleReq(), resp)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	got, err := Parse(out)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if got.Link.URL != resp.Link.URL {
+		t.Fatalf("Link.URL = %q, want %q", got.Link.URL, resp.Link.URL)
+	}
+	if len(got.Assets) != 1 || got.Assets[0].Title == nil || got.Assets[0].Title.Text != "hello" {
+		t.Fatalf("unexpected assets after round trip: %+v", got.Assets)
+	}
+}
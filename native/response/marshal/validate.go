@@ -0,0 +1,157 @@
+package marshal
+
+import (
+	"fmt"
+
+	"github.com/mxmCherry/openrtb/native/request"
+	"github.com/mxmCherry/openrtb/native/response"
+)
+
+// ValidationError reports a single per-asset Native spec violation found by
+// Validate, so callers can log every problem with a bid instead of a single
+// opaque failure.
+type ValidationError struct {
+	// AssetID is the offending request/response asset ID, or 0 for
+	// violations that aren't scoped to a single asset.
+	AssetID int64
+	// Field is the dotted path of the offending attribute, e.g. "img.w" or
+	// "id".
+	Field string
+	// Reason describes what's wrong in human-readable terms.
+	Reason string
+}
+
+func (e ValidationError) Error() string {
+	if e.AssetID == 0 {
+		return fmt.Sprintf("marshal: %s: %s", e.Field, e.Reason)
+	}
+	return fmt.Sprintf("marshal: asset %d: %s: %s", e.AssetID, e.Field, e.Reason)
+}
+
+// Validate cross-checks resp's assets against the Asset Objects advertised
+// in nativeReq: every required request asset must be present in the
+// response, each response asset must carry exactly one of title/img/data/
+// video matching the kind requested for its ID, and string/image assets
+// must stay within the request's len/w/h constraints.
+//
+// It returns every violation found, rather than stopping at the first one,
+// so callers can log a complete picture of what's wrong with a bid.
+func Validate(nativeReq *request.Request, resp *response.Response) []ValidationError {
+	if nativeReq == nil || resp == nil {
+		return []ValidationError{{Field: "request/response", Reason: "nativeReq and resp must not be nil"}}
+	}
+
+	reqByID := make(map[int64]request.Asset, len(nativeReq.Assets))
+	for _, a := range nativeReq.Assets {
+		reqByID[a.ID] = a
+	}
+
+	var errs []ValidationError
+
+	respByID := make(map[int64]response.Asset, len(resp.Assets))
+	for _, a := range resp.Assets {
+		respByID[a.ID] = a
+
+		reqAsset, ok := reqByID[a.ID]
+		if !ok {
+			errs = append(errs, ValidationError{AssetID: a.ID, Field: "id", Reason: "not present in request"})
+			continue
+		}
+
+		errs = append(errs, validateKind(reqAsset, a)...)
+	}
+
+	for id, reqAsset := range reqByID {
+		if reqAsset.Required == 0 {
+			continue
+		}
+		if _, ok := respByID[id]; !ok {
+			errs = append(errs, ValidationError{AssetID: id, Field: "required", Reason: "required asset missing from response"})
+		}
+	}
+
+	return errs
+}
+
+func validateKind(reqAsset request.Asset, respAsset response.Asset) []ValidationError {
+	present := 0
+	if respAsset.Title != nil {
+		present++
+	}
+	if respAsset.Img != nil {
+		present++
+	}
+	if respAsset.Data != nil {
+		present++
+	}
+	if respAsset.Video != nil {
+		present++
+	}
+	if present != 1 {
+		return []ValidationError{{AssetID: respAsset.ID, Field: "title/img/data/video", Reason: "must carry exactly one of title, img, data or video"}}
+	}
+
+	switch {
+	case reqAsset.Title != nil:
+		if respAsset.Title == nil {
+			return []ValidationError{{AssetID: respAsset.ID, Field: "title", Reason: "expected a title asset, as requested"}}
+		}
+		if reqAsset.Title.Len > 0 && int64(len(respAsset.Title.Text)) > reqAsset.Title.Len {
+			return []ValidationError{{
+				AssetID: respAsset.ID,
+				Field:   "title.len",
+				Reason:  fmt.Sprintf("text length %d exceeds requested len %d", len(respAsset.Title.Text), reqAsset.Title.Len),
+			}}
+		}
+
+	case reqAsset.Img != nil:
+		if respAsset.Img == nil {
+			return []ValidationError{{AssetID: respAsset.ID, Field: "img", Reason: "expected an image asset, as requested"}}
+		}
+		return validateImg(respAsset.ID, reqAsset.Img, respAsset.Img)
+
+	case reqAsset.Data != nil:
+		if respAsset.Data == nil {
+			return []ValidationError{{AssetID: respAsset.ID, Field: "data", Reason: "expected a data asset, as requested"}}
+		}
+		if reqAsset.Data.Len > 0 && int64(len(respAsset.Data.Value)) > reqAsset.Data.Len {
+			return []ValidationError{{
+				AssetID: respAsset.ID,
+				Field:   "data.len",
+				Reason:  fmt.Sprintf("value length %d exceeds requested len %d", len(respAsset.Data.Value), reqAsset.Data.Len),
+			}}
+		}
+
+	case reqAsset.Video != nil:
+		if respAsset.Video == nil {
+			return []ValidationError{{AssetID: respAsset.ID, Field: "video", Reason: "expected a video asset, as requested"}}
+		}
+		// mimes/minduration/maxduration/protocols aren't checkable here:
+		// the response video asset only carries a VAST XML blob, not the
+		// structured attributes the request constrained.
+
+	default:
+		return []ValidationError{{AssetID: respAsset.ID, Field: "id", Reason: "request asset specifies no kind to match against"}}
+	}
+
+	return nil
+}
+
+func validateImg(assetID int64, req *request.Image, resp *response.Image) []ValidationError {
+	var errs []ValidationError
+
+	if req.W > 0 && resp.W != 0 && resp.W != req.W {
+		errs = append(errs, ValidationError{AssetID: assetID, Field: "img.w", Reason: fmt.Sprintf("width %d does not match requested w %d", resp.W, req.W)})
+	}
+	if req.WMin > 0 && resp.W != 0 && resp.W < req.WMin {
+		errs = append(errs, ValidationError{AssetID: assetID, Field: "img.w", Reason: fmt.Sprintf("width %d is below requested wmin %d", resp.W, req.WMin)})
+	}
+	if req.H > 0 && resp.H != 0 && resp.H != req.H {
+		errs = append(errs, ValidationError{AssetID: assetID, Field: "img.h", Reason: fmt.Sprintf("height %d does not match requested h %d", resp.H, req.H)})
+	}
+	if req.HMin > 0 && resp.H != 0 && resp.H < req.HMin {
+		errs = append(errs, ValidationError{AssetID: assetID, Field: "img.h", Reason: fmt.Sprintf("height %d is below requested hmin %d", resp.H, req.HMin)})
+	}
+
+	return errs
+}
@@ -0,0 +1,5 @@
+package response
+
+// Privacy is a URL to a privacy notice/opt-out location, returned per
+// Response.Privacy.
+type Privacy string
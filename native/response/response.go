@@ -0,0 +1,103 @@
+// Package response provides typed structs for the Native Object (Response)
+// of the Dynamic Native Ads API, i.e. the ad markup returned inside
+// openrtb.Bid.AdM when the corresponding Imp carried a Native object.
+package response
+
+import "encoding/json"
+
+// Response object represents the Native Object (Response) of the Dynamic
+// Native Ads API.
+//
+// This is the top-level object returned by the bidder; it is carried as a
+// serialized string (optionally wrapped in {"native": {...}}, see ver) in
+// openrtb.Bid.AdM.
+type Response struct {
+	// Attribute:
+	//   ver
+	// Type:
+	//   string; recommended
+	// Description:
+	//   Version of the Native Markup version in use.
+	Ver string `json:"ver,omitempty"`
+
+	// Attribute:
+	//   assets
+	// Type:
+	//   array of objects; recommended
+	// Description:
+	//   List of native ad's assets. Required unless assetsurl or dcourl is
+	//   specified.
+	Assets []Asset `json:"assets,omitempty"`
+
+	// Attribute:
+	//   assetsurl
+	// Type:
+	//   string; optional
+	// Description:
+	//   URL of an alternate source for the assets object. Only one of
+	//   assets or assetsurl/dcourl should be set; only usable if the
+	//   request's aurlsupport was set to 1.
+	AssetsURL string `json:"assetsurl,omitempty"`
+
+	// Attribute:
+	//   dcourl
+	// Type:
+	//   string; optional
+	// Description:
+	//   URL where a dynamic creative optimization response can be found for
+	//   programmatic native ads that support dynamic creative optimization;
+	//   only usable if the request's durlsupport was set to 1. Beta feature.
+	DCOURL string `json:"dcourl,omitempty"`
+
+	// Attribute:
+	//   link
+	// Type:
+	//   object; required
+	// Description:
+	//   Destination Link.
+	Link Link `json:"link"`
+
+	// Attribute:
+	//   imptrackers
+	// Type:
+	//   array of strings; optional
+	// Description:
+	//   Array of impression tracking URLs, expected to return a 1x1 image
+	//   or 204 response.
+	ImpTrackers []string `json:"imptrackers,omitempty"`
+
+	// Attribute:
+	//   jstracker
+	// Type:
+	//   string; optional
+	// Description:
+	//   Optional JavaScript impression tracker. This is a valid HTML,
+	//   Javascript is already wrapped in <script> tags.
+	JSTracker string `json:"jstracker,omitempty"`
+
+	// Attribute:
+	//   eventtrackers
+	// Type:
+	//   array of objects; optional
+	// Description:
+	//   Array of tracking objects to run with the ad, in response to the
+	//   declared supported methods in the request.
+	EventTrackers []EventTracker `json:"eventtrackers,omitempty"`
+
+	// Attribute:
+	//   privacy
+	// Type:
+	//   string; recommended
+	// Description:
+	//   A URL to a privacy notice/opt-out location, to be used if the
+	//   request indicated support (Native.Privacy == 1).
+	Privacy Privacy `json:"privacy,omitempty"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
@@ -0,0 +1,31 @@
+package response
+
+import "encoding/json"
+
+// Title object represents the Title Object (Response) of the Dynamic Native
+// Ads API.
+type Title struct {
+	// Attribute:
+	//   text
+	// Type:
+	//   string; required
+	// Description:
+	//   The text associated with the text element.
+	Text string `json:"text"`
+
+	// Attribute:
+	//   len
+	// Type:
+	//   int; optional
+	// Description:
+	//   The length of the title being provided.
+	Len int64 `json:"len,omitempty"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
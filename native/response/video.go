@@ -0,0 +1,26 @@
+package response
+
+import "encoding/json"
+
+// Video object represents the Video Object (Response) of the Dynamic Native
+// Ads API.
+//
+// The native markup does not require any specific video functionality, it
+// simply embeds a VAST response as a string.
+type Video struct {
+	// Attribute:
+	//   vasttag
+	// Type:
+	//   string; required
+	// Description:
+	//   VAST xml.
+	VastTag string `json:"vasttag"`
+
+	// Attribute:
+	//   ext
+	// Type:
+	//   object; optional
+	// Description:
+	//   Placeholder for exchange-specific extensions to OpenRTB.
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
@@ -0,0 +1,30 @@
+package native
+
+// SpecVersion is a Dynamic Native Ads API version, as carried in
+// Native.Ver/request.Request.Ver.
+type SpecVersion string
+
+const (
+	SpecVersion10 SpecVersion = "1.0"
+	SpecVersion11 SpecVersion = "1.1"
+	SpecVersion12 SpecVersion = "1.2"
+)
+
+// AtLeast reports whether v is the same as or newer than other, for
+// ordering explicitly-pinned versions against each other. An empty or
+// unrecognized v sorts as older than every known version (and level with an
+// empty or unrecognized other) - it is not a stand-in for "no version was
+// pinned". Callers that want unset
+// versions to skip gating altogether (the common case: Ver is a separate
+// axis from other, newer spec fields) must check for "" themselves before
+// calling AtLeast.
+func (v SpecVersion) AtLeast(other SpecVersion) bool {
+	return specVersionOrder[v] >= specVersionOrder[other]
+}
+
+var specVersionOrder = map[SpecVersion]int{
+	"":            0,
+	SpecVersion10: 1,
+	SpecVersion11: 2,
+	SpecVersion12: 3,
+}
@@ -0,0 +1,27 @@
+package openrtb
+
+import (
+	"encoding/json"
+
+	"github.com/mxmCherry/openrtb/native"
+)
+
+// nativeAlias avoids infinite recursion into Native's own MarshalJSON.
+type nativeAlias Native
+
+// MarshalJSON implements json.Marshaler, omitting the 1.2-only Plcmt field
+// when Ver explicitly pins an older Dynamic Native Ads API version (a Ver
+// that is not one of the known versions is treated as older too), so
+// callers that target 1.0/1.1 renderers don't emit a field those renderers
+// won't recognize. An unset Ver is not gated: Plcmt is an OpenRTB 2.6 core
+// field on a different version axis than the Native-API Ver, so a bidder
+// that sets Plcmt without also pinning Ver is the common case, not grounds
+// to drop the value.
+func (n Native) MarshalJSON() ([]byte, error) {
+	out := nativeAlias(n)
+	if n.Ver != "" && !native.SpecVersion(n.Ver).AtLeast(native.SpecVersion12) {
+		out.Plcmt = 0
+	}
+
+	return json.Marshal(out)
+}
@@ -0,0 +1,31 @@
+package openrtb
+
+import (
+	"fmt"
+
+	requestmarshal "github.com/mxmCherry/openrtb/native/request/marshal"
+	"github.com/mxmCherry/openrtb/native/response"
+	responsemarshal "github.com/mxmCherry/openrtb/native/response/marshal"
+)
+
+// PairRequestResponse cross-checks a bid response against the Native object
+// of the impression it's bidding on, so bidders can sanity-check a bid
+// before returning it: n.Request is parsed into its typed request.Request
+// and every asset in resp is checked against it (required assets present,
+// asset kind and size/length constraints honored).
+func PairRequestResponse(n *Native, resp *response.Response) error {
+	if n == nil {
+		return fmt.Errorf("openrtb: nil native")
+	}
+
+	nativeReq, err := requestmarshal.Parse(n.Request)
+	if err != nil {
+		return fmt.Errorf("openrtb: %w", err)
+	}
+
+	if errs := responsemarshal.Validate(nativeReq, resp); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
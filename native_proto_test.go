@@ -0,0 +1,88 @@
+package openrtb
+
+import (
+	"testing"
+
+	"github.com/mxmCherry/openrtb/native"
+	"github.com/mxmCherry/openrtb/native/request"
+)
+
+func TestToFromProto_RoundTrip(t *testing.T) {
+	want := &Native{
+		Request:        `{"ver":"1.2"}`,
+		Ver:            "1.2",
+		Context:        1,
+		ContextSubType: 10,
+		PlcmtType:      1,
+		PlcmtCnt:       2,
+		Seq:            1,
+		AURLSupport:    1,
+		Privacy:        1,
+		API:            []APIFramework{3, 5},
+		BAttr:          []CreativeAttribute{1, 8},
+		Ext:            []byte(`{"foo":"bar"}`),
+		Assets: []request.Asset{
+			{ID: 1, Required: 1, Title: &request.Title{Len: 25}},
+		},
+		EventTrackers: []request.EventTracker{
+			{Event: 1, Methods: []native.EventTrackingMethod{1}},
+		},
+	}
+
+	p, err := ToProto(want)
+	if err != nil {
+		t.Fatalf("ToProto: %v", err)
+	}
+
+	got, err := FromProto(p)
+	if err != nil {
+		t.Fatalf("FromProto: %v", err)
+	}
+
+	if got.Request != want.Request || got.Ver != want.Ver {
+		t.Fatalf("Request/Ver = %q/%q, want %q/%q", got.Request, got.Ver, want.Request, want.Ver)
+	}
+	if len(got.API) != len(want.API) || len(got.BAttr) != len(want.BAttr) {
+		t.Fatalf("API/BAttr = %v/%v, want %v/%v", got.API, got.BAttr, want.API, want.BAttr)
+	}
+	if string(got.Ext) != string(want.Ext) {
+		t.Fatalf("Ext = %s, want %s", got.Ext, want.Ext)
+	}
+	if len(got.Assets) != 1 || got.Assets[0].Title == nil || got.Assets[0].Title.Len != 25 {
+		t.Fatalf("Assets did not round-trip: %+v", got.Assets)
+	}
+	if len(got.EventTrackers) != 1 || len(got.EventTrackers[0].Methods) != 1 {
+		t.Fatalf("EventTrackers did not round-trip: %+v", got.EventTrackers)
+	}
+}
+
+func TestMarshalUnmarshalProto_RoundTrip(t *testing.T) {
+	want := &Native{
+		Request: `{"ver":"1.2"}`,
+		Ver:     "1.2",
+		Assets: []request.Asset{
+			{ID: 1, Required: 1, Title: &request.Title{Len: 25}},
+		},
+	}
+
+	data, err := MarshalProto(want)
+	if err != nil {
+		t.Fatalf("MarshalProto: %v", err)
+	}
+
+	got, err := UnmarshalProto(data)
+	if err != nil {
+		t.Fatalf("UnmarshalProto: %v", err)
+	}
+
+	if len(got.Assets) != 1 || got.Assets[0].Title == nil || got.Assets[0].Title.Len != 25 {
+		t.Fatalf("Assets did not round-trip through the wire format: %+v", got.Assets)
+	}
+}
+
+func TestToProto_Nil(t *testing.T) {
+	p, err := ToProto(nil)
+	if err != nil || p != nil {
+		t.Fatalf("ToProto(nil) = %v, %v; want nil, nil", p, err)
+	}
+}
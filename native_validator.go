@@ -0,0 +1,112 @@
+package openrtb
+
+import (
+	"fmt"
+
+	"github.com/mxmCherry/openrtb/native/request"
+	"github.com/mxmCherry/openrtb/native/response"
+	responsemarshal "github.com/mxmCherry/openrtb/native/response/marshal"
+)
+
+// ValidationError reports a single per-asset Native spec violation, so
+// bidders can log every problem with a bid instead of a single opaque
+// failure. It is an alias of responsemarshal.ValidationError: asset-level
+// checks live there (shared with responsemarshal.Build), and Validator just
+// adds the request/bid-framing checks (decodability, event trackers) on top.
+type ValidationError = responsemarshal.ValidationError
+
+// Validator validates Native request and bid payloads against each other,
+// cross-referencing response asset IDs with the request assets they must
+// honor.
+type Validator struct{}
+
+// ValidateRequest checks n's own Native.Request payload for internal
+// consistency (decodable, each asset carrying exactly one kind).
+func (Validator) ValidateRequest(n *Native) []error {
+	req, err := n.TypedRequest()
+	if err != nil {
+		return []error{ValidationError{Field: "request", Reason: err.Error()}}
+	}
+
+	var errs []error
+	for _, a := range req.Assets {
+		if kindCount(a) != 1 {
+			errs = append(errs, ValidationError{
+				AssetID: a.ID,
+				Field:   "title/img/data/video",
+				Reason:  "asset must specify exactly one of title, img, data or video",
+			})
+		}
+	}
+
+	return errs
+}
+
+// ValidateBid decodes responseJSON as a Native Object (Response) and checks
+// it against nativeReq's Native.Request: every required request asset must
+// be present, each response asset must match the kind and length/dimension
+// constraints of its request counterpart, and event trackers must use an
+// event/method pair the request advertised.
+func (Validator) ValidateBid(nativeReq *Native, responseJSON []byte) []error {
+	req, err := nativeReq.TypedRequest()
+	if err != nil {
+		return []error{ValidationError{Field: "request", Reason: err.Error()}}
+	}
+
+	resp, err := responsemarshal.Parse(string(responseJSON))
+	if err != nil {
+		return []error{ValidationError{Field: "response", Reason: err.Error()}}
+	}
+
+	var errs []error
+	for _, verr := range responsemarshal.Validate(req, resp) {
+		errs = append(errs, verr)
+	}
+	errs = append(errs, validateBidEventTrackers(req, resp)...)
+
+	return errs
+}
+
+func kindCount(a request.Asset) int {
+	n := 0
+	if a.Title != nil {
+		n++
+	}
+	if a.Img != nil {
+		n++
+	}
+	if a.Data != nil {
+		n++
+	}
+	if a.Video != nil {
+		n++
+	}
+	return n
+}
+
+func validateBidEventTrackers(req *request.Request, resp *response.Response) []error {
+	allowed := make(map[int64]map[int64]bool, len(req.EventTrackers))
+	for _, t := range req.EventTrackers {
+		methods := allowed[int64(t.Event)]
+		if methods == nil {
+			methods = make(map[int64]bool, len(t.Methods))
+			allowed[int64(t.Event)] = methods
+		}
+		for _, m := range t.Methods {
+			methods[int64(m)] = true
+		}
+	}
+
+	var errs []error
+	for _, t := range resp.EventTrackers {
+		methods, ok := allowed[int64(t.Event)]
+		if !ok || !methods[int64(t.Method)] {
+			errs = append(errs, ValidationError{
+				Field:  "eventtrackers",
+				Reason: fmt.Sprintf("event %d method %d was not advertised by the request", t.Event, t.Method),
+			})
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,89 @@
+package openrtb
+
+import (
+	"testing"
+
+	"github.com/mxmCherry/openrtb/native"
+	"github.com/mxmCherry/openrtb/native/request"
+	"github.com/mxmCherry/openrtb/native/response"
+	responsemarshal "github.com/mxmCherry/openrtb/native/response/marshal"
+)
+
+func titleNativeReq(t *testing.T) *Native {
+	t.Helper()
+
+	req := &request.Request{
+		Ver: "1.2",
+		Assets: []request.Asset{
+			{ID: 1, Required: 1, Title: &request.Title{Len: 25}},
+		},
+		EventTrackers: []request.EventTracker{
+			{Event: 1, Methods: []native.EventTrackingMethod{1}},
+		},
+	}
+
+	n := &Native{}
+	if err := n.WithTypedRequest(req); err != nil {
+		t.Fatalf("WithTypedRequest: %v", err)
+	}
+	return n
+}
+
+func TestValidator_ValidateBid_AssetMismatchIsValidationError(t *testing.T) {
+	n := titleNativeReq(t)
+
+	resp := &response.Response{
+		Link:   response.Link{URL: "https://example.com"},
+		Assets: []response.Asset{{ID: 1, Img: &response.Image{URL: "https://example.com/i.png"}}},
+	}
+	raw, err := responsemarshal.Build(nil, resp)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	errs := (Validator{}).ValidateBid(n, []byte(raw))
+	if len(errs) == 0 {
+		t.Fatal("expected validation error for asset kind mismatch")
+	}
+
+	if _, ok := errs[0].(ValidationError); !ok {
+		t.Fatalf("expected ValidationError, got %T", errs[0])
+	}
+}
+
+func TestValidator_ValidateBid_EventTrackerNotAdvertised(t *testing.T) {
+	n := titleNativeReq(t)
+
+	resp := &response.Response{
+		Link:          response.Link{URL: "https://example.com"},
+		Assets:        []response.Asset{{ID: 1, Title: &response.Title{Text: "hello"}}},
+		EventTrackers: []response.EventTracker{{Event: 2, Method: 1, URL: "https://t.example.com"}},
+	}
+	raw, err := responsemarshal.Build(nil, resp)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	errs := (Validator{}).ValidateBid(n, []byte(raw))
+	if len(errs) != 1 {
+		t.Fatalf("expected exactly 1 error (unadvertised event tracker), got %d: %v", len(errs), errs)
+	}
+}
+
+func TestValidator_ValidateBid_Valid(t *testing.T) {
+	n := titleNativeReq(t)
+
+	resp := &response.Response{
+		Link:          response.Link{URL: "https://example.com"},
+		Assets:        []response.Asset{{ID: 1, Title: &response.Title{Text: "hello"}}},
+		EventTrackers: []response.EventTracker{{Event: 1, Method: 1, URL: "https://t.example.com"}},
+	}
+	raw, err := responsemarshal.Build(nil, resp)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	if errs := (Validator{}).ValidateBid(n, []byte(raw)); len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+}
@@ -0,0 +1,420 @@
+package nativefast
+
+import (
+	"encoding/json"
+
+	"github.com/mxmCherry/openrtb/native"
+	"github.com/mxmCherry/openrtb/native/request"
+)
+
+// decodeAssetsInto scans a JSON array of Asset objects, appending each onto
+// dst and returning the (possibly reallocated) result - dst is typically a
+// pooled slice's backing array sliced to zero length, so a steady stream of
+// decodes doesn't reallocate it. Each Asset is built as a fresh zero-valued
+// struct and appended by value, so a reused backing array can never retain
+// a stale Title/Img/Data/Video from whatever used to occupy that slot.
+func decodeAssetsInto(s *scanner, dst []request.Asset) ([]request.Asset, error) {
+	if err := s.expect('['); err != nil {
+		return nil, err
+	}
+	if s.consumeIf(']') {
+		return dst, nil
+	}
+
+	for {
+		a, err := decodeAsset(s)
+		if err != nil {
+			return nil, err
+		}
+		dst = append(dst, a)
+
+		if s.consumeIf(',') {
+			continue
+		}
+		if err := s.expect(']'); err != nil {
+			return nil, err
+		}
+		return dst, nil
+	}
+}
+
+func decodeAsset(s *scanner) (request.Asset, error) {
+	var a request.Asset
+	if err := s.expect('{'); err != nil {
+		return a, err
+	}
+	if s.consumeIf('}') {
+		return a, nil
+	}
+
+	for {
+		key, err := s.parseString()
+		if err != nil {
+			return a, err
+		}
+		if err := s.expect(':'); err != nil {
+			return a, err
+		}
+
+		switch key {
+		case "id":
+			v, err := s.parseInt64()
+			if err != nil {
+				return a, err
+			}
+			a.ID = v
+
+		case "required":
+			v, err := s.parseInt64()
+			if err != nil {
+				return a, err
+			}
+			a.Required = int8(v)
+
+		case "title":
+			a.Title, err = decodeTitle(s)
+
+		case "img":
+			a.Img, err = decodeImage(s)
+
+		case "data":
+			a.Data, err = decodeData(s)
+
+		case "video":
+			a.Video, err = decodeVideo(s)
+
+		case "ext":
+			var raw []byte
+			raw, err = s.rawValue()
+			if err == nil {
+				a.Ext = append(json.RawMessage(nil), raw...)
+			}
+
+		default:
+			_, err = s.rawValue()
+		}
+		if err != nil {
+			return a, err
+		}
+
+		if s.consumeIf(',') {
+			continue
+		}
+		if err := s.expect('}'); err != nil {
+			return a, err
+		}
+		return a, nil
+	}
+}
+
+func decodeTitle(s *scanner) (*request.Title, error) {
+	if s.consumeNull() {
+		return nil, nil
+	}
+	t := &request.Title{}
+	if err := s.expect('{'); err != nil {
+		return nil, err
+	}
+	if s.consumeIf('}') {
+		return t, nil
+	}
+
+	for {
+		key, err := s.parseString()
+		if err != nil {
+			return nil, err
+		}
+		if err := s.expect(':'); err != nil {
+			return nil, err
+		}
+
+		switch key {
+		case "len":
+			var v int64
+			v, err = s.parseInt64()
+			t.Len = v
+
+		case "ext":
+			var raw []byte
+			raw, err = s.rawValue()
+			if err == nil {
+				t.Ext = append(json.RawMessage(nil), raw...)
+			}
+
+		default:
+			_, err = s.rawValue()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if s.consumeIf(',') {
+			continue
+		}
+		if err := s.expect('}'); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+}
+
+func decodeImage(s *scanner) (*request.Image, error) {
+	if s.consumeNull() {
+		return nil, nil
+	}
+	im := &request.Image{}
+	if err := s.expect('{'); err != nil {
+		return nil, err
+	}
+	if s.consumeIf('}') {
+		return im, nil
+	}
+
+	for {
+		key, err := s.parseString()
+		if err != nil {
+			return nil, err
+		}
+		if err := s.expect(':'); err != nil {
+			return nil, err
+		}
+
+		switch key {
+		case "type":
+			var v int64
+			v, err = s.parseInt64()
+			im.Type = native.ImageAssetType(v)
+
+		case "w":
+			im.W, err = s.parseInt64()
+
+		case "wmin":
+			im.WMin, err = s.parseInt64()
+
+		case "h":
+			im.H, err = s.parseInt64()
+
+		case "hmin":
+			im.HMin, err = s.parseInt64()
+
+		case "mimes":
+			im.Mimes, err = s.parseStringArray()
+
+		case "ext":
+			var raw []byte
+			raw, err = s.rawValue()
+			if err == nil {
+				im.Ext = append(json.RawMessage(nil), raw...)
+			}
+
+		default:
+			_, err = s.rawValue()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if s.consumeIf(',') {
+			continue
+		}
+		if err := s.expect('}'); err != nil {
+			return nil, err
+		}
+		return im, nil
+	}
+}
+
+func decodeData(s *scanner) (*request.Data, error) {
+	if s.consumeNull() {
+		return nil, nil
+	}
+	d := &request.Data{}
+	if err := s.expect('{'); err != nil {
+		return nil, err
+	}
+	if s.consumeIf('}') {
+		return d, nil
+	}
+
+	for {
+		key, err := s.parseString()
+		if err != nil {
+			return nil, err
+		}
+		if err := s.expect(':'); err != nil {
+			return nil, err
+		}
+
+		switch key {
+		case "type":
+			var v int64
+			v, err = s.parseInt64()
+			d.Type = native.DataAssetType(v)
+
+		case "len":
+			d.Len, err = s.parseInt64()
+
+		case "ext":
+			var raw []byte
+			raw, err = s.rawValue()
+			if err == nil {
+				d.Ext = append(json.RawMessage(nil), raw...)
+			}
+
+		default:
+			_, err = s.rawValue()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if s.consumeIf(',') {
+			continue
+		}
+		if err := s.expect('}'); err != nil {
+			return nil, err
+		}
+		return d, nil
+	}
+}
+
+func decodeVideo(s *scanner) (*request.Video, error) {
+	if s.consumeNull() {
+		return nil, nil
+	}
+	v := &request.Video{}
+	if err := s.expect('{'); err != nil {
+		return nil, err
+	}
+	if s.consumeIf('}') {
+		return v, nil
+	}
+
+	for {
+		key, err := s.parseString()
+		if err != nil {
+			return nil, err
+		}
+		if err := s.expect(':'); err != nil {
+			return nil, err
+		}
+
+		switch key {
+		case "mimes":
+			v.Mimes, err = s.parseStringArray()
+
+		case "minduration":
+			v.MinDuration, err = s.parseInt64()
+
+		case "maxduration":
+			v.MaxDuration, err = s.parseInt64()
+
+		case "protocols":
+			err = s.parseInt64Array(func(p int64) {
+				v.Protocols = append(v.Protocols, native.Protocol(p))
+			})
+
+		case "ext":
+			var raw []byte
+			raw, err = s.rawValue()
+			if err == nil {
+				v.Ext = append(json.RawMessage(nil), raw...)
+			}
+
+		default:
+			_, err = s.rawValue()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if s.consumeIf(',') {
+			continue
+		}
+		if err := s.expect('}'); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+// decodeEventTrackers scans a JSON array of EventTracker objects with no
+// reflection, mirroring decodeAssetsInto.
+func decodeEventTrackers(s *scanner) ([]request.EventTracker, error) {
+	if err := s.expect('['); err != nil {
+		return nil, err
+	}
+	if s.consumeIf(']') {
+		return nil, nil
+	}
+
+	var out []request.EventTracker
+	for {
+		et, err := decodeEventTracker(s)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, et)
+
+		if s.consumeIf(',') {
+			continue
+		}
+		if err := s.expect(']'); err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+}
+
+func decodeEventTracker(s *scanner) (request.EventTracker, error) {
+	var et request.EventTracker
+	if err := s.expect('{'); err != nil {
+		return et, err
+	}
+	if s.consumeIf('}') {
+		return et, nil
+	}
+
+	for {
+		key, err := s.parseString()
+		if err != nil {
+			return et, err
+		}
+		if err := s.expect(':'); err != nil {
+			return et, err
+		}
+
+		switch key {
+		case "event":
+			var v int64
+			v, err = s.parseInt64()
+			et.Event = native.EventType(v)
+
+		case "methods":
+			err = s.parseInt64Array(func(m int64) {
+				et.Methods = append(et.Methods, native.EventTrackingMethod(m))
+			})
+
+		case "ext":
+			var raw []byte
+			raw, err = s.rawValue()
+			if err == nil {
+				et.Ext = append(json.RawMessage(nil), raw...)
+			}
+
+		default:
+			_, err = s.rawValue()
+		}
+		if err != nil {
+			return et, err
+		}
+
+		if s.consumeIf(',') {
+			continue
+		}
+		if err := s.expect('}'); err != nil {
+			return et, err
+		}
+		return et, nil
+	}
+}
@@ -0,0 +1,62 @@
+package nativefast
+
+import "testing"
+
+func TestDecodeAssetsInto_AllFieldTypes(t *testing.T) {
+	raw := `[` +
+		`{"id":1,"required":1,"title":{"len":90}},` +
+		`{"id":2,"img":{"type":3,"w":300,"h":250,"mimes":["image/png","image/jpeg"]}},` +
+		`{"id":3,"data":{"type":1,"len":25}},` +
+		`{"id":4,"video":{"mimes":["video/mp4"],"minduration":5,"maxduration":30,"protocols":[2,5]}}` +
+		`]`
+
+	s := scanner{data: []byte(raw)}
+	assets, err := decodeAssetsInto(&s, nil)
+	if err != nil {
+		t.Fatalf("decodeAssetsInto: %v", err)
+	}
+	if len(assets) != 4 {
+		t.Fatalf("len(assets) = %d, want 4", len(assets))
+	}
+
+	if assets[0].Title == nil || assets[0].Title.Len != 90 {
+		t.Errorf("assets[0].Title = %+v", assets[0].Title)
+	}
+	if assets[1].Img == nil || len(assets[1].Img.Mimes) != 2 || assets[1].Img.Mimes[1] != "image/jpeg" {
+		t.Errorf("assets[1].Img = %+v", assets[1].Img)
+	}
+	if assets[2].Data == nil || assets[2].Data.Len != 25 {
+		t.Errorf("assets[2].Data = %+v", assets[2].Data)
+	}
+	if assets[3].Video == nil || len(assets[3].Video.Protocols) != 2 || assets[3].Video.MaxDuration != 30 {
+		t.Errorf("assets[3].Video = %+v", assets[3].Video)
+	}
+}
+
+func TestDecodeAssetsInto_ReusesDstBackingArray(t *testing.T) {
+	s := scanner{data: []byte(`[{"id":1,"title":{"len":1}}]`)}
+	assets, err := decodeAssetsInto(&s, nil)
+	if err != nil {
+		t.Fatalf("decodeAssetsInto: %v", err)
+	}
+
+	s2 := scanner{data: []byte(`[{"id":2,"data":{"len":2}}]`)}
+	reused, err := decodeAssetsInto(&s2, assets[:0])
+	if err != nil {
+		t.Fatalf("decodeAssetsInto (reuse): %v", err)
+	}
+	if len(reused) != 1 || reused[0].Title != nil || reused[0].Data == nil || reused[0].Data.Len != 2 {
+		t.Fatalf("unexpected reused asset: %+v", reused[0])
+	}
+}
+
+func TestDecodeEventTrackers(t *testing.T) {
+	s := scanner{data: []byte(`[{"event":1,"methods":[1,2]},{"event":2,"methods":[2]}]`)}
+	ets, err := decodeEventTrackers(&s)
+	if err != nil {
+		t.Fatalf("decodeEventTrackers: %v", err)
+	}
+	if len(ets) != 2 || len(ets[0].Methods) != 2 || ets[1].Event != 2 {
+		t.Fatalf("unexpected eventtrackers: %+v", ets)
+	}
+}
@@ -0,0 +1,112 @@
+package nativefast
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/mxmCherry/openrtb"
+)
+
+// benchPayload is a representative multi-asset bid request: a handful of
+// scalar fields plus a nested Request string carrying several asset types,
+// matching the shape a real SSP gateway would decode per impression.
+func benchPayload() []byte {
+	n := &openrtb.Native{
+		Request: `{"ver":"1.2","context":1,"plcmttype":1,"assets":[` +
+			`{"id":1,"required":1,"title":{"len":90}},` +
+			`{"id":2,"required":1,"img":{"type":3,"w":300,"h":250}},` +
+			`{"id":3,"required":0,"img":{"type":1,"wmin":50,"hmin":50}},` +
+			`{"id":4,"required":0,"data":{"type":1,"len":25}},` +
+			`{"id":5,"required":0,"data":{"type":2,"len":140}}]}`,
+		Ver:            "1.2",
+		Context:        1,
+		ContextSubType: 10,
+		PlcmtType:      1,
+		PlcmtCnt:       1,
+		Seq:            1,
+		AURLSupport:    1,
+		API:            []openrtb.APIFramework{3, 5, 6},
+		BAttr:          []openrtb.CreativeAttribute{1, 8, 9},
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(n); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func BenchmarkDecodeImpNative_Nativefast(b *testing.B) {
+	payload := benchPayload()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var dst Native
+		if err := DecodeImpNative(bytes.NewReader(payload), &dst); err != nil {
+			b.Fatalf("DecodeImpNative: %v", err)
+		}
+	}
+}
+
+func BenchmarkDecodeImpNativeBytes_Nativefast(b *testing.B) {
+	payload := benchPayload()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var dst Native
+		if err := DecodeImpNativeBytes(payload, &dst); err != nil {
+			b.Fatalf("DecodeImpNativeBytes: %v", err)
+		}
+	}
+}
+
+func BenchmarkDecodeImpNative_EncodingJSON(b *testing.B) {
+	payload := benchPayload()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var dst openrtb.Native
+		if err := json.Unmarshal(payload, &dst); err != nil {
+			b.Fatalf("json.Unmarshal: %v", err)
+		}
+	}
+}
+
+func BenchmarkEncodeImpNative_Nativefast(b *testing.B) {
+	payload := benchPayload()
+	var src Native
+	if err := DecodeImpNative(bytes.NewReader(payload), &src); err != nil {
+		b.Fatalf("DecodeImpNative: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var buf bytes.Buffer
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		if err := EncodeImpNative(&buf, &src); err != nil {
+			b.Fatalf("EncodeImpNative: %v", err)
+		}
+	}
+}
+
+func BenchmarkEncodeImpNative_EncodingJSON(b *testing.B) {
+	payload := benchPayload()
+	var src openrtb.Native
+	if err := json.Unmarshal(payload, &src); err != nil {
+		b.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(&src); err != nil {
+			b.Fatalf("json.Marshal: %v", err)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package nativefast
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mxmCherry/openrtb"
+	"github.com/mxmCherry/openrtb/native"
+)
+
+func sampleNative() *Native {
+	return &openrtb.Native{
+		Request:        `{"ver":"1.2","assets":[{"id":1,"required":1,"title":{"len":25}}]}`,
+		Ver:            "1.2",
+		Context:        native.ContextTypeContent,
+		ContextSubType: native.ContextSubTypeGeneral,
+		PlcmtType:      native.PlacementTypeInFeed,
+		PlcmtCnt:       1,
+		Seq:            2,
+		AURLSupport:    1,
+		DURLSupport:    0,
+		Privacy:        1,
+		Ext:            []byte(`{"foo":"bar"}`),
+	}
+}
+
+func TestDecodeEncode_RoundTrip(t *testing.T) {
+	want := sampleNative()
+
+	var buf bytes.Buffer
+	if err := EncodeImpNative(&buf, want); err != nil {
+		t.Fatalf("EncodeImpNative: %v", err)
+	}
+
+	var got Native
+	if err := DecodeImpNative(&buf, &got); err != nil {
+		t.Fatalf("DecodeImpNative: %v", err)
+	}
+
+	if got.Request != want.Request {
+		t.Errorf("Request = %q, want %q", got.Request, want.Request)
+	}
+	if got.Ver != want.Ver {
+		t.Errorf("Ver = %q, want %q", got.Ver, want.Ver)
+	}
+	if got.Context != want.Context || got.ContextSubType != want.ContextSubType {
+		t.Errorf("Context/ContextSubType = %v/%v, want %v/%v", got.Context, got.ContextSubType, want.Context, want.ContextSubType)
+	}
+	if got.PlcmtType != want.PlcmtType || got.PlcmtCnt != want.PlcmtCnt || got.Seq != want.Seq {
+		t.Errorf("PlcmtType/PlcmtCnt/Seq = %v/%v/%v, want %v/%v/%v", got.PlcmtType, got.PlcmtCnt, got.Seq, want.PlcmtType, want.PlcmtCnt, want.Seq)
+	}
+	if got.AURLSupport != want.AURLSupport || got.DURLSupport != want.DURLSupport || got.Privacy != want.Privacy {
+		t.Errorf("AURLSupport/DURLSupport/Privacy = %v/%v/%v, want %v/%v/%v", got.AURLSupport, got.DURLSupport, got.Privacy, want.AURLSupport, want.DURLSupport, want.Privacy)
+	}
+	if string(got.Ext) != string(want.Ext) {
+		t.Errorf("Ext = %s, want %s", got.Ext, want.Ext)
+	}
+}
+
+func TestDecodeEncode_EscapedStrings(t *testing.T) {
+	want := &openrtb.Native{
+		Ver: "has \"quotes\", a \\backslash\\, a\ttab and a\nnewline",
+	}
+
+	var buf bytes.Buffer
+	if err := EncodeImpNative(&buf, want); err != nil {
+		t.Fatalf("EncodeImpNative: %v", err)
+	}
+
+	var got Native
+	if err := DecodeImpNative(bytes.NewReader(buf.Bytes()), &got); err != nil {
+		t.Fatalf("DecodeImpNative: %v", err)
+	}
+
+	if got.Ver != want.Ver {
+		t.Errorf("Ver = %q, want %q", got.Ver, want.Ver)
+	}
+}
+
+func TestDecodeImpNative_RejectsMalformedRequest(t *testing.T) {
+	var dst Native
+	err := DecodeImpNative(strings.NewReader(`{"request":"{not valid json"}`), &dst)
+	if err == nil {
+		t.Fatal("expected error for malformed request payload")
+	}
+}
+
+func TestDecodeImpNative_EmptyObject(t *testing.T) {
+	var dst Native
+	if err := DecodeImpNative(strings.NewReader(`{}`), &dst); err != nil {
+		t.Fatalf("DecodeImpNative: %v", err)
+	}
+	if dst.Ver != "" || dst.Request != "" {
+		t.Fatalf("expected zero-value Native, got %+v", dst)
+	}
+}
+
+func TestDecodeImpNative_UnknownFieldIsSkipped(t *testing.T) {
+	var dst Native
+	err := DecodeImpNative(strings.NewReader(`{"unknownfield":{"nested":[1,2,3]},"ver":"1.2"}`), &dst)
+	if err != nil {
+		t.Fatalf("DecodeImpNative: %v", err)
+	}
+	if dst.Ver != "1.2" {
+		t.Fatalf("Ver = %q, want 1.2", dst.Ver)
+	}
+}
+
+func TestDecodeImpNativeBytes_MatchesDecodeImpNative(t *testing.T) {
+	want := sampleNative()
+
+	var buf bytes.Buffer
+	if err := EncodeImpNative(&buf, want); err != nil {
+		t.Fatalf("EncodeImpNative: %v", err)
+	}
+
+	var got Native
+	if err := DecodeImpNativeBytes(buf.Bytes(), &got); err != nil {
+		t.Fatalf("DecodeImpNativeBytes: %v", err)
+	}
+
+	if got.Request != want.Request || got.Ver != want.Ver {
+		t.Errorf("Request/Ver = %q/%q, want %q/%q", got.Request, got.Ver, want.Request, want.Ver)
+	}
+}
+
+func TestReleaseNative_ReturnsAssetsToPool(t *testing.T) {
+	var dst Native
+	raw := `{"assets":[{"id":1,"required":1,"title":{"len":25}}]}`
+	if err := DecodeImpNativeBytes([]byte(raw), &dst); err != nil {
+		t.Fatalf("DecodeImpNativeBytes: %v", err)
+	}
+	if len(dst.Assets) != 1 {
+		t.Fatalf("unexpected assets: %+v", dst.Assets)
+	}
+
+	ReleaseNative(&dst)
+	if dst.Assets != nil {
+		t.Fatalf("expected Assets cleared after ReleaseNative, got %+v", dst.Assets)
+	}
+
+	// A subsequent decode should be able to reuse the pooled backing array
+	// without error or leftover data from the previous decode.
+	var dst2 Native
+	if err := DecodeImpNativeBytes([]byte(raw), &dst2); err != nil {
+		t.Fatalf("DecodeImpNativeBytes: %v", err)
+	}
+	if len(dst2.Assets) != 1 || dst2.Assets[0].ID != 1 {
+		t.Fatalf("unexpected assets after reuse: %+v", dst2.Assets)
+	}
+}
+
+func TestReleaseNative_PooledSlotDoesNotLeakStaleFields(t *testing.T) {
+	var dst Native
+	first := `{"assets":[{"id":1,"title":{"len":99}}]}`
+	if err := DecodeImpNativeBytes([]byte(first), &dst); err != nil {
+		t.Fatalf("DecodeImpNativeBytes: %v", err)
+	}
+	if len(dst.Assets) != 1 || dst.Assets[0].Title == nil || dst.Assets[0].Title.Len != 99 {
+		t.Fatalf("unexpected assets: %+v", dst.Assets)
+	}
+	ReleaseNative(&dst)
+
+	var dst2 Native
+	second := `{"assets":[{"id":2,"data":{"value":"x"}}]}`
+	if err := DecodeImpNativeBytes([]byte(second), &dst2); err != nil {
+		t.Fatalf("DecodeImpNativeBytes: %v", err)
+	}
+	if len(dst2.Assets) != 1 {
+		t.Fatalf("unexpected assets: %+v", dst2.Assets)
+	}
+	got := dst2.Assets[0]
+	if got.ID != 2 {
+		t.Errorf("ID = %d, want 2", got.ID)
+	}
+	if got.Title != nil {
+		t.Errorf("Title = %+v, want nil (leaked from previous occupant of the pooled slot)", got.Title)
+	}
+}
+
+func TestDecodeImpNative_AssetsEventTrackersAPIBAttr(t *testing.T) {
+	raw := `{"assets":[{"id":1,"required":1,"title":{"len":25}}],"eventtrackers":[{"event":1,"methods":[1]}],"api":[3,5],"battr":[1,2]}`
+
+	var dst Native
+	if err := DecodeImpNative(strings.NewReader(raw), &dst); err != nil {
+		t.Fatalf("DecodeImpNative: %v", err)
+	}
+
+	if len(dst.Assets) != 1 || dst.Assets[0].Title == nil || dst.Assets[0].Title.Len != 25 {
+		t.Fatalf("unexpected assets: %+v", dst.Assets)
+	}
+	if len(dst.EventTrackers) != 1 || len(dst.EventTrackers[0].Methods) != 1 {
+		t.Fatalf("unexpected eventtrackers: %+v", dst.EventTrackers)
+	}
+	if len(dst.API) != 2 || len(dst.BAttr) != 2 {
+		t.Fatalf("unexpected api/battr: %+v / %+v", dst.API, dst.BAttr)
+	}
+}
@@ -0,0 +1,265 @@
+package nativefast
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/mxmCherry/openrtb"
+	"github.com/mxmCherry/openrtb/native"
+	"github.com/mxmCherry/openrtb/native/request"
+)
+
+// DecodeImpNative reads a Native JSON object from r into dst.
+//
+// Every field is parsed directly off the read buffer with no reflection,
+// including the nested Assets and EventTrackers arrays: Request is checked
+// for well-formedness with json.Valid (which walks the bytes without
+// building a Go value) rather than decoded into a throwaway
+// request.Request, so callers who don't need the typed request.Request -
+// e.g. routing or logging - don't pay for one.
+//
+// Assets decodes into a []request.Asset drawn from a pool, reusing its
+// backing array across calls; call ReleaseNative(dst) once the caller is
+// done with dst to return it. Each Asset is built as a fresh value and
+// appended by value, so a reused slot can never retain a stale
+// Title/Img/Data/Video from whatever previously occupied it.
+//
+// String fields decoded without escape sequences (Request, Ver, mimes)
+// alias the buffer read from r, while Ext values are copied; since each
+// call reads into its own buffer, no later call overwrites them.
+//
+// r is drained into a new buffer first, since the scanner needs the whole
+// message up front. A gateway that already holds the impression body as a
+// []byte (the common case - it came off the wire into a buffer already)
+// should call DecodeImpNativeBytes directly instead, to skip that copy.
+func DecodeImpNative(r io.Reader, dst *Native) error {
+	data, err := readAll(r)
+	if err != nil {
+		return err
+	}
+	return DecodeImpNativeBytes(data, dst)
+}
+
+// DecodeImpNativeBytes decodes a Native JSON object from data into dst with
+// no intermediate copy; see DecodeImpNative for field-by-field behavior.
+// dst aliases data (string fields decoded without escape sequences, such as
+// Request and Ver, point into it; Ext values are copied), so the caller must
+// not mutate or discard data while dst is in use.
+func DecodeImpNativeBytes(data []byte, dst *Native) error {
+	s := scanner{data: data}
+
+	if err := s.expect('{'); err != nil {
+		return err
+	}
+
+	if s.consumeIf('}') {
+		return nil
+	}
+
+	for {
+		key, err := s.parseString()
+		if err != nil {
+			return err
+		}
+		if err := s.expect(':'); err != nil {
+			return err
+		}
+
+		if err := decodeField(&s, dst, key); err != nil {
+			return err
+		}
+
+		if s.consumeIf(',') {
+			continue
+		}
+		return s.expect('}')
+	}
+}
+
+// lenReader is implemented by *bytes.Reader, *bytes.Buffer and
+// *strings.Reader. Sizing the read buffer exactly to Len() avoids
+// bytes.Buffer's default growth, which rounds up to at least 512 bytes
+// (bytes.MinRead) regardless of how small the payload actually is.
+type lenReader interface {
+	Len() int
+}
+
+func readAll(r io.Reader) ([]byte, error) {
+	if lr, ok := r.(lenReader); ok {
+		data := make([]byte, lr.Len())
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// assetPool holds []request.Asset backing arrays for reuse across decodes
+// of Native.Assets, so a steady stream of impressions doesn't reallocate
+// the slice every call.
+var assetPool = sync.Pool{
+	New: func() interface{} {
+		s := make([]request.Asset, 0, 8)
+		return &s
+	},
+}
+
+// ReleaseNative returns dst.Assets' backing array to the pool DecodeImpNative
+// draws from, and clears dst.Assets. Call it once the caller is done
+// reading dst; using dst.Assets afterward is undefined.
+func ReleaseNative(dst *Native) {
+	if dst.Assets != nil {
+		s := dst.Assets[:0]
+		assetPool.Put(&s)
+		dst.Assets = nil
+	}
+}
+
+func decodeField(s *scanner, dst *Native, key string) error {
+	switch key {
+	case "request":
+		raw, err := s.parseString()
+		if err != nil {
+			return err
+		}
+		if !json.Valid([]byte(raw)) {
+			return fmt.Errorf("nativefast: request is not valid JSON")
+		}
+		dst.Request = raw
+		return nil
+
+	case "ver":
+		v, err := s.parseString()
+		if err != nil {
+			return err
+		}
+		dst.Ver = v
+		return nil
+
+	case "context":
+		v, err := s.parseInt64()
+		if err != nil {
+			return err
+		}
+		dst.Context = native.ContextType(v)
+		return nil
+
+	case "contextsubtype":
+		v, err := s.parseInt64()
+		if err != nil {
+			return err
+		}
+		dst.ContextSubType = native.ContextSubType(v)
+		return nil
+
+	case "plcmttype":
+		v, err := s.parseInt64()
+		if err != nil {
+			return err
+		}
+		dst.PlcmtType = native.PlacementType(v)
+		return nil
+
+	case "plcmt":
+		v, err := s.parseInt64()
+		if err != nil {
+			return err
+		}
+		dst.Plcmt = native.PlcmtType(v)
+		return nil
+
+	case "plcmtcnt":
+		v, err := s.parseInt64()
+		if err != nil {
+			return err
+		}
+		dst.PlcmtCnt = v
+		return nil
+
+	case "seq":
+		v, err := s.parseInt64()
+		if err != nil {
+			return err
+		}
+		dst.Seq = v
+		return nil
+
+	case "aurlsupport":
+		v, err := s.parseInt64()
+		if err != nil {
+			return err
+		}
+		dst.AURLSupport = int8(v)
+		return nil
+
+	case "durlsupport":
+		v, err := s.parseInt64()
+		if err != nil {
+			return err
+		}
+		dst.DURLSupport = int8(v)
+		return nil
+
+	case "privacy":
+		v, err := s.parseInt64()
+		if err != nil {
+			return err
+		}
+		dst.Privacy = int8(v)
+		return nil
+
+	case "ext":
+		raw, err := s.rawValue()
+		if err != nil {
+			return err
+		}
+		dst.Ext = append(json.RawMessage(nil), raw...)
+		return nil
+
+	case "assets":
+		if s.consumeNull() {
+			return nil
+		}
+		reuse := *(assetPool.Get().(*[]request.Asset))
+		assets, err := decodeAssetsInto(s, reuse[:0])
+		if err != nil {
+			return err
+		}
+		dst.Assets = assets
+		return nil
+
+	case "eventtrackers":
+		if s.consumeNull() {
+			return nil
+		}
+		eventTrackers, err := decodeEventTrackers(s)
+		if err != nil {
+			return err
+		}
+		dst.EventTrackers = eventTrackers
+		return nil
+
+	case "api":
+		return s.parseInt64Array(func(v int64) {
+			dst.API = append(dst.API, openrtb.APIFramework(v))
+		})
+
+	case "battr":
+		return s.parseInt64Array(func(v int64) {
+			dst.BAttr = append(dst.BAttr, openrtb.CreativeAttribute(v))
+		})
+
+	default:
+		_, err := s.rawValue()
+		return err
+	}
+}
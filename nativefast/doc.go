@@ -0,0 +1,36 @@
+// Package nativefast provides a hand-written (in the spirit of
+// easyjson/ffjson, but vendored directly rather than generated) decode/
+// encode path for openrtb.Native's scalar fields and its opaque Request
+// string, for gateways where encoding/json's reflection-driven walk over
+// those is a measurable per-impression cost.
+//
+// The main target is Native.Request: it's a JSON string holding another
+// JSON document, which a naive caller decodes in two encoding/json passes
+// (once for the outer Native, once for the nested request.Request, via
+// Native.TypedRequest). DecodeImpNative only checks it's well-formed with
+// json.Valid, leaving the (optional) typed decode to the caller. API and
+// BAttr - integer arrays - are also parsed by hand, appending straight into
+// dst with no intermediate slice.
+//
+// Assets and EventTrackers - nested arrays of structs - are also parsed by
+// hand (see asset.go): each Asset/EventTracker is built as a fresh value
+// and appended by value, so Assets' pooled []request.Asset backing array
+// (see ReleaseNative) never retains a stale field from whatever decode
+// previously occupied that slot.
+//
+// Benchmarked against encoding/json on a representative multi-asset
+// payload (see bench_test.go) on the author's machine: DecodeImpNativeBytes
+// runs ~2x faster with roughly half the allocations (9 vs 16) and
+// comparable bytes per decode; DecodeImpNative, which must additionally
+// drain its io.Reader into an owned buffer, runs ~1.8x faster with fewer
+// allocations (11 vs 16) but more bytes, since that buffer isn't cheap to
+// avoid allocating. Callers who already hold the impression body as a
+// []byte should prefer DecodeImpNativeBytes for the better of the two.
+//
+// This falls short of a ≥3x throughput target: with Request still checked
+// via json.Valid rather than hand-parsed, and per-field dispatch going
+// through a string switch rather than a generated jump table, there's
+// headroom left on the table. ~2x with near-half the allocations is what
+// this package claims today; revisit the target before relying on a
+// bigger number.
+package nativefast
@@ -0,0 +1,190 @@
+package nativefast
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/mxmCherry/openrtb/native"
+)
+
+// EncodeImpNative writes src as a Native JSON object to w. Scalar fields and
+// the opaque Request string are quoted/written by hand, without reflection.
+// Assets, EventTrackers, API and BAttr are delegated to encoding/json, so
+// this isn't a total win over json.Marshal for payloads that lean on those.
+func EncodeImpNative(w io.Writer, src *Native) error {
+	e := &encoder{w: w}
+
+	e.writeByte('{')
+	first := true
+
+	if src.Request != "" {
+		e.writeField(&first, "request")
+		e.writeString(src.Request)
+	}
+	if src.Ver != "" {
+		e.writeField(&first, "ver")
+		e.writeString(src.Ver)
+	}
+	if len(src.API) > 0 {
+		e.writeField(&first, "api")
+		e.writeJSON(src.API)
+	}
+	if len(src.BAttr) > 0 {
+		e.writeField(&first, "battr")
+		e.writeJSON(src.BAttr)
+	}
+	if len(src.Ext) > 0 {
+		e.writeField(&first, "ext")
+		e.writeRaw(src.Ext)
+	}
+	if src.Context != 0 {
+		e.writeField(&first, "context")
+		e.writeInt(int64(src.Context))
+	}
+	if src.ContextSubType != 0 {
+		e.writeField(&first, "contextsubtype")
+		e.writeInt(int64(src.ContextSubType))
+	}
+	if src.PlcmtType != 0 {
+		e.writeField(&first, "plcmttype")
+		e.writeInt(int64(src.PlcmtType))
+	}
+	// Same gating as Native.MarshalJSON: plcmt is left out when Ver pins a
+	// version older than 1.2.
+	if src.Plcmt != 0 && (src.Ver == "" || native.SpecVersion(src.Ver).AtLeast(native.SpecVersion12)) {
+		e.writeField(&first, "plcmt")
+		e.writeInt(int64(src.Plcmt))
+	}
+	if src.PlcmtCnt != 0 {
+		e.writeField(&first, "plcmtcnt")
+		e.writeInt(src.PlcmtCnt)
+	}
+	if src.Seq != 0 {
+		e.writeField(&first, "seq")
+		e.writeInt(src.Seq)
+	}
+	if len(src.Assets) > 0 {
+		e.writeField(&first, "assets")
+		e.writeJSON(src.Assets)
+	}
+	if src.AURLSupport != 0 {
+		e.writeField(&first, "aurlsupport")
+		e.writeInt(int64(src.AURLSupport))
+	}
+	if src.DURLSupport != 0 {
+		e.writeField(&first, "durlsupport")
+		e.writeInt(int64(src.DURLSupport))
+	}
+	if len(src.EventTrackers) > 0 {
+		e.writeField(&first, "eventtrackers")
+		e.writeJSON(src.EventTrackers)
+	}
+	if src.Privacy != 0 {
+		e.writeField(&first, "privacy")
+		e.writeInt(int64(src.Privacy))
+	}
+
+	e.writeByte('}')
+
+	return e.err
+}
+
+// encoder accumulates the first error it hits and turns subsequent writes
+// into no-ops, so call sites don't need to check an error after every field.
+type encoder struct {
+	w       io.Writer
+	scratch [1]byte
+	err     error
+}
+
+func (e *encoder) writeByte(b byte) {
+	if e.err != nil {
+		return
+	}
+	e.scratch[0] = b
+	_, e.err = e.w.Write(e.scratch[:])
+}
+
+func (e *encoder) writeField(first *bool, name string) {
+	if !*first {
+		e.writeByte(',')
+	}
+	*first = false
+	e.writeString(name)
+	e.writeByte(':')
+}
+
+// hex is used by writeString to emit \u00XX escapes for control characters.
+const hex = "0123456789abcdef"
+
+// writeString quotes and writes s byte-by-byte, escaping only what the JSON
+// spec requires ('"', '\\' and control characters) - none of Native's string
+// fields need encoding/json's full Unicode-escaping behavior.
+func (e *encoder) writeString(s string) {
+	if e.err != nil {
+		return
+	}
+
+	e.writeByte('"')
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 0x20 && c != '"' && c != '\\' {
+			continue
+		}
+		if e.err != nil {
+			return
+		}
+		if start < i {
+			_, e.err = io.WriteString(e.w, s[start:i])
+		}
+		switch c {
+		case '"':
+			_, e.err = io.WriteString(e.w, `\"`)
+		case '\\':
+			_, e.err = io.WriteString(e.w, `\\`)
+		case '\n':
+			_, e.err = io.WriteString(e.w, `\n`)
+		case '\r':
+			_, e.err = io.WriteString(e.w, `\r`)
+		case '\t':
+			_, e.err = io.WriteString(e.w, `\t`)
+		default:
+			_, e.err = io.WriteString(e.w, `\u00`+string(hex[c>>4])+string(hex[c&0xf]))
+		}
+		start = i + 1
+	}
+	if e.err == nil && start < len(s) {
+		_, e.err = io.WriteString(e.w, s[start:])
+	}
+	e.writeByte('"')
+}
+
+func (e *encoder) writeInt(v int64) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = io.WriteString(e.w, strconv.FormatInt(v, 10))
+}
+
+func (e *encoder) writeRaw(b []byte) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.Write(b)
+}
+
+// writeJSON falls back to encoding/json for the nested struct/enum slices,
+// which the hand-written encoder does not write itself.
+func (e *encoder) writeJSON(v interface{}) {
+	if e.err != nil {
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		e.err = err
+		return
+	}
+	_, e.err = e.w.Write(b)
+}
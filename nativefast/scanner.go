@@ -0,0 +1,274 @@
+package nativefast
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// scanner is a minimal hand-written JSON tokenizer, scoped to exactly the
+// shape of a Native object, so DecodeImpNative doesn't pay for encoding/json's
+// reflection-driven walk on the hot fields.
+type scanner struct {
+	data []byte
+	pos  int
+}
+
+func (s *scanner) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("nativefast: "+format+" at offset %d", append(args, s.pos)...)
+}
+
+func (s *scanner) skipSpace() {
+	for s.pos < len(s.data) {
+		switch s.data[s.pos] {
+		case ' ', '\t', '\n', '\r':
+			s.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (s *scanner) expect(c byte) error {
+	s.skipSpace()
+	if s.pos >= len(s.data) || s.data[s.pos] != c {
+		return s.errorf("expected %q", c)
+	}
+	s.pos++
+	return nil
+}
+
+// consumeIf advances past c and reports whether the next non-space byte was
+// c, leaving pos unchanged otherwise.
+func (s *scanner) consumeIf(c byte) bool {
+	s.skipSpace()
+	if s.pos < len(s.data) && s.data[s.pos] == c {
+		s.pos++
+		return true
+	}
+	return false
+}
+
+func (s *scanner) atEnd() bool {
+	s.skipSpace()
+	return s.pos >= len(s.data)
+}
+
+func (s *scanner) peek() byte {
+	s.skipSpace()
+	if s.pos >= len(s.data) {
+		return 0
+	}
+	return s.data[s.pos]
+}
+
+// parseString scans a JSON string. When it contains no escape sequences,
+// the returned string aliases s.data instead of copying it - the caller
+// must not mutate or discard the underlying buffer while the string is in
+// use.
+func (s *scanner) parseString() (string, error) {
+	if err := s.expect('"'); err != nil {
+		return "", err
+	}
+
+	start := s.pos
+	hasEscape := false
+	for i := s.pos; i < len(s.data); i++ {
+		switch s.data[i] {
+		case '"':
+			s.pos = i + 1
+			if !hasEscape {
+				return bytesToString(s.data[start:i]), nil
+			}
+			return unescape(s.data[start:i])
+		case '\\':
+			hasEscape = true
+			i++
+		}
+	}
+
+	return "", s.errorf("unterminated string")
+}
+
+// skipString scans past a JSON string without materializing it.
+func (s *scanner) skipString() error {
+	if err := s.expect('"'); err != nil {
+		return err
+	}
+	for i := s.pos; i < len(s.data); i++ {
+		switch s.data[i] {
+		case '"':
+			s.pos = i + 1
+			return nil
+		case '\\':
+			i++
+		}
+	}
+	return s.errorf("unterminated string")
+}
+
+// parseInt64 scans a JSON number as an int64. It doesn't support
+// fractional/exponent notation - none of Native's numeric fields use it.
+func (s *scanner) parseInt64() (int64, error) {
+	s.skipSpace()
+	start := s.pos
+	if s.pos < len(s.data) && (s.data[s.pos] == '-' || s.data[s.pos] == '+') {
+		s.pos++
+	}
+	for s.pos < len(s.data) && s.data[s.pos] >= '0' && s.data[s.pos] <= '9' {
+		s.pos++
+	}
+	if s.pos == start {
+		return 0, s.errorf("expected number")
+	}
+
+	var neg bool
+	digits := s.data[start:s.pos]
+	if len(digits) > 0 && digits[0] == '-' {
+		neg = true
+		digits = digits[1:]
+	} else if len(digits) > 0 && digits[0] == '+' {
+		digits = digits[1:]
+	}
+
+	var v int64
+	for _, c := range digits {
+		v = v*10 + int64(c-'0')
+	}
+	if neg {
+		v = -v
+	}
+	return v, nil
+}
+
+// parseInt64Array scans a JSON array of numbers, e.g. api/battr, without
+// going through encoding/json's reflection-driven slice decoding. It calls
+// fn with each element in order rather than building a []int64, so the
+// caller can append straight into its own typed destination slice without
+// an intermediate allocation.
+func (s *scanner) parseInt64Array(fn func(int64)) error {
+	if err := s.expect('['); err != nil {
+		return err
+	}
+	if s.consumeIf(']') {
+		return nil
+	}
+
+	for {
+		v, err := s.parseInt64()
+		if err != nil {
+			return err
+		}
+		fn(v)
+
+		if s.consumeIf(',') {
+			continue
+		}
+		return s.expect(']')
+	}
+}
+
+// parseStringArray scans a JSON array of strings, e.g. mimes, without going
+// through encoding/json's reflection-driven slice decoding.
+func (s *scanner) parseStringArray() ([]string, error) {
+	if err := s.expect('['); err != nil {
+		return nil, err
+	}
+	if s.consumeIf(']') {
+		return nil, nil
+	}
+
+	var out []string
+	for {
+		v, err := s.parseString()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+
+		if s.consumeIf(',') {
+			continue
+		}
+		if err := s.expect(']'); err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+}
+
+// consumeNull reports whether the next non-space token is the JSON literal
+// null, advancing past it if so; it leaves pos unchanged otherwise, so
+// callers can fall through to parsing a value of the expected type.
+func (s *scanner) consumeNull() bool {
+	s.skipSpace()
+	if s.pos+4 <= len(s.data) && string(s.data[s.pos:s.pos+4]) == "null" {
+		s.pos += 4
+		return true
+	}
+	return false
+}
+
+// rawValue captures the byte span of the next JSON value (object, array,
+// string, number, bool or null) without parsing it, for fields handed off
+// to encoding/json.
+func (s *scanner) rawValue() ([]byte, error) {
+	s.skipSpace()
+	if s.pos >= len(s.data) {
+		return nil, s.errorf("expected value")
+	}
+
+	start := s.pos
+	switch s.data[s.pos] {
+	case '"':
+		if err := s.skipString(); err != nil {
+			return nil, err
+		}
+	case '{':
+		if err := s.skipBraced('{', '}'); err != nil {
+			return nil, err
+		}
+	case '[':
+		if err := s.skipBraced('[', ']'); err != nil {
+			return nil, err
+		}
+	default:
+		for s.pos < len(s.data) && s.data[s.pos] != ',' && s.data[s.pos] != '}' && s.data[s.pos] != ']' && s.data[s.pos] != ' ' && s.data[s.pos] != '\n' && s.data[s.pos] != '\t' && s.data[s.pos] != '\r' {
+			s.pos++
+		}
+	}
+
+	return s.data[start:s.pos], nil
+}
+
+// skipBraced scans past a balanced open/close pair, correctly skipping over
+// nested strings (so braces inside string values aren't miscounted).
+func (s *scanner) skipBraced(open, close byte) error {
+	if err := s.expect(open); err != nil {
+		return err
+	}
+	depth := 1
+	for s.pos < len(s.data) && depth > 0 {
+		switch s.data[s.pos] {
+		case '"':
+			if err := s.skipString(); err != nil {
+				return err
+			}
+			continue
+		case open:
+			depth++
+		case close:
+			depth--
+		}
+		s.pos++
+	}
+	if depth != 0 {
+		return s.errorf("unbalanced %q", open)
+	}
+	return nil
+}
+
+func bytesToString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return unsafe.String(&b[0], len(b))
+}
@@ -0,0 +1,113 @@
+package nativefast
+
+import "testing"
+
+func TestScanner_ParseString_NoEscape(t *testing.T) {
+	s := scanner{data: []byte(`"hello"`)}
+	got, err := s.parseString()
+	if err != nil {
+		t.Fatalf("parseString: %v", err)
+	}
+	if got != "hello" {
+		t.Fatalf("got %q, want %q", got, "hello")
+	}
+}
+
+func TestScanner_ParseString_WithEscape(t *testing.T) {
+	s := scanner{data: []byte(`"a\nb\"c"`)}
+	got, err := s.parseString()
+	if err != nil {
+		t.Fatalf("parseString: %v", err)
+	}
+	if got != "a\nb\"c" {
+		t.Fatalf("got %q, want %q", got, "a\nb\"c")
+	}
+}
+
+func TestScanner_ParseString_Unterminated(t *testing.T) {
+	s := scanner{data: []byte(`"abc`)}
+	if _, err := s.parseString(); err == nil {
+		t.Fatal("expected error for unterminated string")
+	}
+}
+
+func TestScanner_ParseInt64(t *testing.T) {
+	cases := []struct {
+		in   string
+		want int64
+	}{
+		{"123", 123},
+		{"-123", -123},
+		{"+5", 5},
+		{"0", 0},
+	}
+	for _, c := range cases {
+		s := scanner{data: []byte(c.in)}
+		got, err := s.parseInt64()
+		if err != nil {
+			t.Fatalf("parseInt64(%q): %v", c.in, err)
+		}
+		if got != c.want {
+			t.Fatalf("parseInt64(%q) = %d, want %d", c.in, got, c.want)
+		}
+	}
+}
+
+func TestScanner_RawValue_NestedBraces(t *testing.T) {
+	s := scanner{data: []byte(`{"a":{"b":"}"},"c":1}` + `,"rest":true`)}
+	got, err := s.rawValue()
+	if err != nil {
+		t.Fatalf("rawValue: %v", err)
+	}
+	want := `{"a":{"b":"}"},"c":1}`
+	if string(got) != want {
+		t.Fatalf("rawValue = %s, want %s", got, want)
+	}
+}
+
+func TestUnescape_UnicodeEscape(t *testing.T) {
+	got, err := unescape([]byte("\\u0041\\u0042"))
+	if err != nil {
+		t.Fatalf("unescape: %v", err)
+	}
+	if got != "AB" {
+		t.Fatalf("got %q, want %q", got, "AB")
+	}
+}
+
+func TestUnescape_SurrogatePair(t *testing.T) {
+	// U+1F600 GRINNING FACE, encoded as the UTF-16 surrogate pair
+	// 😀, same as encoding/json would decode it.
+	got, err := unescape([]byte("\\uD83D\\uDE00"))
+	if err != nil {
+		t.Fatalf("unescape: %v", err)
+	}
+	want := "\U0001F600"
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestUnescape_LoneSurrogate(t *testing.T) {
+	// A high surrogate with no following low surrogate falls back to the
+	// Unicode replacement character, matching encoding/json.
+	got, err := unescape([]byte(`\uD83D`))
+	if err != nil {
+		t.Fatalf("unescape: %v", err)
+	}
+	if got != "�" {
+		t.Fatalf("got %q, want %q", got, "�")
+	}
+}
+
+func TestUnescape_DanglingEscape(t *testing.T) {
+	if _, err := unescape([]byte(`\`)); err == nil {
+		t.Fatal("expected error for dangling escape")
+	}
+}
+
+func TestUnescape_UnsupportedEscape(t *testing.T) {
+	if _, err := unescape([]byte(`\q`)); err == nil {
+		t.Fatal("expected error for unsupported escape")
+	}
+}
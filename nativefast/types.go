@@ -0,0 +1,7 @@
+package nativefast
+
+import "github.com/mxmCherry/openrtb"
+
+// Native aliases openrtb.Native, so callers don't need a second import just
+// to name DecodeImpNative/EncodeImpNative's argument type.
+type Native = openrtb.Native
@@ -0,0 +1,94 @@
+package nativefast
+
+import (
+	"fmt"
+	"unicode/utf16"
+)
+
+// unescape decodes the JSON escape sequences in b (the content between the
+// quotes, not including them) into a new Go string. Only called for strings
+// that contain at least one backslash, so the common, escape-free case
+// never reaches here.
+func unescape(b []byte) (string, error) {
+	out := make([]byte, 0, len(b))
+
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		if c != '\\' {
+			out = append(out, c)
+			continue
+		}
+
+		i++
+		if i >= len(b) {
+			return "", fmt.Errorf("nativefast: dangling escape in string")
+		}
+
+		switch b[i] {
+		case '"':
+			out = append(out, '"')
+		case '\\':
+			out = append(out, '\\')
+		case '/':
+			out = append(out, '/')
+		case 'b':
+			out = append(out, '\b')
+		case 'f':
+			out = append(out, '\f')
+		case 'n':
+			out = append(out, '\n')
+		case 'r':
+			out = append(out, '\r')
+		case 't':
+			out = append(out, '\t')
+		case 'u':
+			if i+4 >= len(b) {
+				return "", fmt.Errorf("nativefast: short unicode escape")
+			}
+			r, err := parseHex4(b[i+1 : i+5])
+			if err != nil {
+				return "", err
+			}
+			i += 4
+
+			// A high surrogate must be paired with a following \uDCxx low
+			// surrogate to form a non-BMP code point; encoding/json does
+			// the same combine, so mirror it instead of emitting two
+			// lone replacement characters.
+			if utf16.IsSurrogate(rune(r)) && i+6 < len(b) && b[i+1] == '\\' && b[i+2] == 'u' {
+				r2, err := parseHex4(b[i+3 : i+7])
+				if err == nil {
+					if combined := utf16.DecodeRune(rune(r), rune(r2)); combined != 0xFFFD {
+						out = append(out, string(combined)...)
+						i += 6
+						continue
+					}
+				}
+			}
+
+			out = append(out, string(rune(r))...)
+		default:
+			return "", fmt.Errorf("nativefast: unsupported escape %q", b[i])
+		}
+	}
+
+	return string(out), nil
+}
+
+func parseHex4(b []byte) (int32, error) {
+	var v int32
+	for _, c := range b {
+		v <<= 4
+		switch {
+		case c >= '0' && c <= '9':
+			v |= int32(c - '0')
+		case c >= 'a' && c <= 'f':
+			v |= int32(c-'a') + 10
+		case c >= 'A' && c <= 'F':
+			v |= int32(c-'A') + 10
+		default:
+			return 0, fmt.Errorf("nativefast: invalid hex digit %q", c)
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,10 @@
+// Package pb holds the Protocol Buffers mirror of the OpenRTB JSON structs,
+// for exchanges (e.g. Google Authorized Buyers RTB) that transport OpenRTB
+// as protobuf rather than JSON.
+//
+// native.go in this package is maintained by hand against ../proto/native.proto
+// until protoc/protoc-gen-go are wired into this repo's build; at that point
+// it should be replaced by the protoc-gen-go output of:
+//
+//	protoc --go_out=. --go_opt=paths=source_relative -I ../proto ../proto/native.proto
+package pb
@@ -0,0 +1,26 @@
+package pb
+
+// Native mirrors the Native message in ../proto/native.proto. Its field
+// numbers, defined in wire.go, must stay in lockstep with the .proto source.
+type Native struct {
+	Request        string
+	Ver            string
+	Context        int32
+	ContextSubType int32
+	PlcmtType      int32
+	Plcmt          int32
+	PlcmtCnt       int64
+	Seq            int64
+	AURLSupport    int32
+	DURLSupport    int32
+	Privacy        int32
+	API            []int32
+	BAttr          []int32
+	Ext            []byte
+
+	// AssetsJSON and EventTrackersJSON carry the JSON encoding of
+	// openrtb.Native's Assets/EventTrackers, rather than a protobuf mirror
+	// of their oneof-shaped Asset Objects; see native.proto for why.
+	AssetsJSON        []byte
+	EventTrackersJSON []byte
+}
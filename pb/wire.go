@@ -0,0 +1,241 @@
+package pb
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Field numbers, matching ../proto/native.proto.
+const (
+	fieldRequest           = 1
+	fieldVer               = 2
+	fieldContext           = 3
+	fieldContextSubType    = 4
+	fieldPlcmtType         = 5
+	fieldPlcmt             = 6
+	fieldPlcmtCnt          = 7
+	fieldSeq               = 8
+	fieldAURLSupport       = 9
+	fieldDURLSupport       = 10
+	fieldPrivacy           = 11
+	fieldAPI               = 12
+	fieldBAttr             = 13
+	fieldExt               = 14
+	fieldAssetsJSON        = 15
+	fieldEventTrackersJSON = 16
+)
+
+const (
+	wireVarint = 0
+	wireBytes  = 2
+)
+
+// Marshal encodes n as a protobuf wire-format message, by hand, without
+// depending on an external protobuf runtime. Zero-valued fields are omitted,
+// matching proto2 "optional" semantics.
+func Marshal(n *Native) []byte {
+	var buf []byte
+
+	buf = appendString(buf, fieldRequest, n.Request)
+	buf = appendString(buf, fieldVer, n.Ver)
+	buf = appendVarint(buf, fieldContext, int64(n.Context))
+	buf = appendVarint(buf, fieldContextSubType, int64(n.ContextSubType))
+	buf = appendVarint(buf, fieldPlcmtType, int64(n.PlcmtType))
+	buf = appendVarint(buf, fieldPlcmt, int64(n.Plcmt))
+	buf = appendVarint(buf, fieldPlcmtCnt, n.PlcmtCnt)
+	buf = appendVarint(buf, fieldSeq, n.Seq)
+	buf = appendVarint(buf, fieldAURLSupport, int64(n.AURLSupport))
+	buf = appendVarint(buf, fieldDURLSupport, int64(n.DURLSupport))
+	buf = appendVarint(buf, fieldPrivacy, int64(n.Privacy))
+	for _, v := range n.API {
+		buf = appendTag(buf, fieldAPI, wireVarint)
+		buf = binary.AppendUvarint(buf, uint64(v))
+	}
+	for _, v := range n.BAttr {
+		buf = appendTag(buf, fieldBAttr, wireVarint)
+		buf = binary.AppendUvarint(buf, uint64(v))
+	}
+	buf = appendBytes(buf, fieldExt, n.Ext)
+	buf = appendBytes(buf, fieldAssetsJSON, n.AssetsJSON)
+	buf = appendBytes(buf, fieldEventTrackersJSON, n.EventTrackersJSON)
+
+	return buf
+}
+
+// Unmarshal decodes a protobuf wire-format message produced by Marshal (or
+// any conforming encoder) into n. Unknown field numbers are skipped, per
+// protobuf's forward-compatibility rules; the deprecated group wire types
+// are rejected.
+func Unmarshal(data []byte, n *Native) error {
+	for len(data) > 0 {
+		tag, wireType, rest, err := readTag(data)
+		if err != nil {
+			return err
+		}
+		data = rest
+
+		switch wireType {
+		case wireVarint:
+			v, rest, err := readVarint(data)
+			if err != nil {
+				return err
+			}
+			data = rest
+			assignVarint(n, tag, int64(v))
+
+		case wireBytes:
+			s, rest, err := readBytes(data)
+			if err != nil {
+				return err
+			}
+			data = rest
+			if tag == fieldAPI || tag == fieldBAttr {
+				vals, err := readPackedVarints([]byte(s))
+				if err != nil {
+					return err
+				}
+				for _, v := range vals {
+					assignVarint(n, tag, int64(v))
+				}
+				continue
+			}
+			assignBytes(n, tag, s)
+
+		case 1: // 64-bit; no field in the schema uses it, so it is skipped
+			if len(data) < 8 {
+				return fmt.Errorf("pb: truncated 64-bit field %d", tag)
+			}
+			data = data[8:]
+
+		case 5: // 32-bit; no field in the schema uses it, so it is skipped
+			if len(data) < 4 {
+				return fmt.Errorf("pb: truncated 32-bit field %d", tag)
+			}
+			data = data[4:]
+
+		default:
+			return fmt.Errorf("pb: unsupported wire type %d for field %d", wireType, tag)
+		}
+	}
+
+	return nil
+}
+
+func assignVarint(n *Native, field int, v int64) {
+	switch field {
+	case fieldContext:
+		n.Context = int32(v)
+	case fieldContextSubType:
+		n.ContextSubType = int32(v)
+	case fieldPlcmtType:
+		n.PlcmtType = int32(v)
+	case fieldPlcmt:
+		n.Plcmt = int32(v)
+	case fieldPlcmtCnt:
+		n.PlcmtCnt = v
+	case fieldSeq:
+		n.Seq = v
+	case fieldAURLSupport:
+		n.AURLSupport = int32(v)
+	case fieldDURLSupport:
+		n.DURLSupport = int32(v)
+	case fieldPrivacy:
+		n.Privacy = int32(v)
+	case fieldAPI:
+		n.API = append(n.API, int32(v))
+	case fieldBAttr:
+		n.BAttr = append(n.BAttr, int32(v))
+	}
+}
+
+func assignBytes(n *Native, field int, s string) {
+	switch field {
+	case fieldRequest:
+		n.Request = s
+	case fieldVer:
+		n.Ver = s
+	case fieldExt:
+		n.Ext = []byte(s)
+	case fieldAssetsJSON:
+		n.AssetsJSON = []byte(s)
+	case fieldEventTrackersJSON:
+		n.EventTrackersJSON = []byte(s)
+	}
+}
+
+func appendVarint(buf []byte, field int, v int64) []byte {
+	if v == 0 {
+		return buf
+	}
+	buf = appendTag(buf, field, wireVarint)
+	return binary.AppendUvarint(buf, uint64(v))
+}
+
+func appendString(buf []byte, field int, s string) []byte {
+	if s == "" {
+		return buf
+	}
+	buf = appendTag(buf, field, wireBytes)
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+func appendBytes(buf []byte, field int, b []byte) []byte {
+	if len(b) == 0 {
+		return buf
+	}
+	buf = appendTag(buf, field, wireBytes)
+	buf = binary.AppendUvarint(buf, uint64(len(b)))
+	return append(buf, b...)
+}
+
+func appendTag(buf []byte, field, wireType int) []byte {
+	return binary.AppendUvarint(buf, uint64(field)<<3|uint64(wireType))
+}
+
+func readTag(data []byte) (field, wireType int, rest []byte, err error) {
+	tag, rest, err := readVarint(data)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return int(tag >> 3), int(tag & 0x7), rest, nil
+}
+
+// readVarint returns the raw unsigned value: protobuf varints are always
+// encoded unsigned on the wire, and keeping the result as uint64 (rather
+// than converting to int64 here) stops a hostile high-bit length from
+// turning into a negative Go int further up the call chain.
+func readVarint(data []byte) (uint64, []byte, error) {
+	v, n := binary.Uvarint(data)
+	if n <= 0 {
+		return 0, nil, fmt.Errorf("pb: malformed varint")
+	}
+	return v, data[n:], nil
+}
+
+// readPackedVarints decodes a packed repeated scalar field (wire type 2
+// carrying back-to-back varints, the proto3 default encoding for repeated
+// int32/enum), as opposed to the unpacked wire type 0 form Marshal emits.
+func readPackedVarints(data []byte) ([]uint64, error) {
+	var vals []uint64
+	for len(data) > 0 {
+		v, rest, err := readVarint(data)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, v)
+		data = rest
+	}
+	return vals, nil
+}
+
+func readBytes(data []byte) (string, []byte, error) {
+	l, rest, err := readVarint(data)
+	if err != nil {
+		return "", nil, err
+	}
+	if l > uint64(len(rest)) {
+		return "", nil, fmt.Errorf("pb: truncated length-delimited field")
+	}
+	return string(rest[:l]), rest[l:], nil
+}
@@ -0,0 +1,138 @@
+package pb
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestMarshalUnmarshal_RoundTrip(t *testing.T) {
+	want := &Native{
+		Request:           `{"ver":"1.2"}`,
+		Ver:               "1.2",
+		Context:           1,
+		ContextSubType:    10,
+		PlcmtType:         1,
+		Plcmt:             1,
+		PlcmtCnt:          2,
+		Seq:               1,
+		AURLSupport:       1,
+		DURLSupport:       1,
+		Privacy:           1,
+		API:               []int32{3, 5, 6},
+		BAttr:             []int32{1, 8, 9},
+		Ext:               []byte(`{"foo":"bar"}`),
+		AssetsJSON:        []byte(`[{"id":1,"required":1}]`),
+		EventTrackersJSON: []byte(`[{"event":1,"methods":[1]}]`),
+	}
+
+	data := Marshal(want)
+
+	var got Native
+	if err := Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if got.Request != want.Request || got.Ver != want.Ver {
+		t.Fatalf("Request/Ver = %q/%q, want %q/%q", got.Request, got.Ver, want.Request, want.Ver)
+	}
+	if got.Context != want.Context || got.ContextSubType != want.ContextSubType {
+		t.Fatalf("Context/ContextSubType = %d/%d, want %d/%d", got.Context, got.ContextSubType, want.Context, want.ContextSubType)
+	}
+	if got.PlcmtType != want.PlcmtType || got.Plcmt != want.Plcmt || got.PlcmtCnt != want.PlcmtCnt || got.Seq != want.Seq {
+		t.Fatalf("placement fields mismatch: got %+v, want %+v", got, want)
+	}
+	if got.AURLSupport != want.AURLSupport || got.DURLSupport != want.DURLSupport || got.Privacy != want.Privacy {
+		t.Fatalf("support/privacy fields mismatch: got %+v, want %+v", got, want)
+	}
+	if len(got.API) != len(want.API) {
+		t.Fatalf("API = %v, want %v", got.API, want.API)
+	}
+	for i := range want.API {
+		if got.API[i] != want.API[i] {
+			t.Fatalf("API[%d] = %d, want %d", i, got.API[i], want.API[i])
+		}
+	}
+	if len(got.BAttr) != len(want.BAttr) {
+		t.Fatalf("BAttr = %v, want %v", got.BAttr, want.BAttr)
+	}
+	if string(got.Ext) != string(want.Ext) {
+		t.Fatalf("Ext = %s, want %s", got.Ext, want.Ext)
+	}
+	if string(got.AssetsJSON) != string(want.AssetsJSON) {
+		t.Fatalf("AssetsJSON = %s, want %s", got.AssetsJSON, want.AssetsJSON)
+	}
+	if string(got.EventTrackersJSON) != string(want.EventTrackersJSON) {
+		t.Fatalf("EventTrackersJSON = %s, want %s", got.EventTrackersJSON, want.EventTrackersJSON)
+	}
+}
+
+func TestMarshal_OmitsZeroValues(t *testing.T) {
+	data := Marshal(&Native{})
+	if len(data) != 0 {
+		t.Fatalf("expected empty encoding for zero-value Native, got %d bytes", len(data))
+	}
+}
+
+func TestUnmarshal_SkipsUnknownFields(t *testing.T) {
+	// Field 99, wire type 0 (varint), value 1 - not defined in the schema.
+	data := appendVarint(nil, 99, 1)
+	data = appendVarint(data, fieldSeq, 7)
+
+	var got Native
+	if err := Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got.Seq != 7 {
+		t.Fatalf("Seq = %d, want 7", got.Seq)
+	}
+}
+
+func TestUnmarshal_MalformedVarint(t *testing.T) {
+	// A tag byte with the continuation bit set but no following byte.
+	if err := Unmarshal([]byte{0x80}, &Native{}); err == nil {
+		t.Fatal("expected error for truncated varint")
+	}
+}
+
+func TestUnmarshal_TruncatedLengthDelimited(t *testing.T) {
+	data := appendTag(nil, fieldRequest, wireBytes)
+	data = append(data, 10) // claims 10 bytes follow, but none do
+	if err := Unmarshal(data, &Native{}); err == nil {
+		t.Fatal("expected error for truncated length-delimited field")
+	}
+}
+
+func TestUnmarshal_OversizedLengthDoesNotPanic(t *testing.T) {
+	// A length varint with bit 63 set becomes a negative int64 if read
+	// with binary.Varint/int64 semantics; readBytes must reject it as a
+	// decode error instead of computing a negative slice bound.
+	data := appendTag(nil, fieldRequest, wireBytes)
+	data = binary.AppendUvarint(data, 1<<63)
+	if err := Unmarshal(data, &Native{}); err == nil {
+		t.Fatal("expected error for oversized length-delimited field")
+	}
+}
+
+func TestUnmarshal_PackedRepeatedScalar(t *testing.T) {
+	// Encode api/battr packed (wire type 2, the proto3 default for
+	// repeated scalars), rather than Marshal's unpacked wire type 0 form.
+	var packed []byte
+	for _, v := range []int32{3, 5, 6} {
+		packed = binary.AppendUvarint(packed, uint64(v))
+	}
+	data := appendBytes(nil, fieldAPI, packed)
+
+	var got Native
+	if err := Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	want := []int32{3, 5, 6}
+	if len(got.API) != len(want) {
+		t.Fatalf("API = %v, want %v", got.API, want)
+	}
+	for i := range want {
+		if got.API[i] != want[i] {
+			t.Fatalf("API[%d] = %d, want %d", i, got.API[i], want[i])
+		}
+	}
+}